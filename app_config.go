@@ -3,10 +3,10 @@ package bconf
 import (
 	"fmt"
 	"os"
-	"reflect"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -26,9 +26,28 @@ type AppConfig struct {
 	appDescription   string
 	loaders          []Loader
 	orderedFieldSets FieldSets
-	fieldSetLock     sync.Mutex
+	fieldSetLock     sync.RWMutex
+	currentSnapshot  atomic.Pointer[Snapshot]
 	register         sync.Once
 	registered       bool
+	warningHandler   func(warning string)
+	// pendingWarnings are emitted while the field-set lock is held, and passed to the warning handler once the lock
+	// has been released
+	pendingWarnings []string
+	helpRenderer    HelpRenderer
+	// fieldChangeHandlers are keyed by '<field-set-key>_<field-key>'
+	fieldChangeHandlers    map[string][]func(oldValue, newValue any)
+	fieldSetChangeHandlers map[string][]func(changes []FieldChange)
+	watchOptions           WatchOptions
+	profileFieldSet        string
+	profileField           string
+	profile                string
+	failFast               bool
+	strict                 bool
+	handlePrintConfigFlag  bool
+	// generation is incremented by every change to the field-sets, fields, field values, loaders, or profile field,
+	// so that Watch reloads can detect changes made while a reload was staged
+	generation uint64
 }
 
 func (c *AppConfig) AppName() string {
@@ -40,6 +59,9 @@ func (c *AppConfig) AppDescription() string {
 }
 
 func (c *AppConfig) SetLoaders(loaders ...Loader) []error {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
 	errs := []error{}
 
 	clonedLoaders := make([]Loader, len(loaders))
@@ -61,10 +83,59 @@ func (c *AppConfig) SetLoaders(loaders ...Loader) []error {
 	}
 
 	c.loaders = clonedLoaders
+	c.generation++
 
 	return nil
 }
 
+// SetFailFast configures Register to stop loading field-sets as soon as one returns errors. By default, Register
+// loads every field-set and returns the errors from all of them.
+func (c *AppConfig) SetFailFast(failFast bool) {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	c.failFast = failFast
+}
+
+// SetStrict configures Register to return errors for keys found in loader sources that do not map to any registered
+// field. Only loaders implementing SourceKeyLoader are checked, and loaders can enable strict mode individually.
+func (c *AppConfig) SetStrict(strict bool) {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	c.strict = strict
+}
+
+// SetWarningHandler sets a function that receives warnings, e.g. when a loader finds a value for a deprecated field or
+// through a field alias. Warnings are discarded when no handler is set. The handler is called once the app-config lock
+// has been released, so it can safely call AppConfig methods.
+func (c *AppConfig) SetWarningHandler(handler func(warning string)) {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	c.warningHandler = handler
+}
+
+// SetProfileField sets the field used to select the active configuration profile. The field must be a String field,
+// and is loaded like any other field, so the profile can be selected by any loader (e.g. an environment variable or
+// flag). Field-sets are loaded after the field-set containing the profile field.
+func (c *AppConfig) SetProfileField(fieldSetKey, fieldKey string) {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	c.profileFieldSet = fieldSetKey
+	c.profileField = fieldKey
+	c.generation++
+}
+
+// Profile returns the active configuration profile, or an empty string if no profile is selected.
+func (c *AppConfig) Profile() string {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	return c.profile
+}
+
 func (c *AppConfig) AddFieldSet(fieldSet *FieldSet) []error {
 	return c.addFieldSet(fieldSet, true)
 }
@@ -91,6 +162,10 @@ func (c *AppConfig) AddFieldSets(fieldSets ...*FieldSet) []error {
 		}
 
 		c.orderedFieldSets = c.orderedFieldSets[:len(c.orderedFieldSets)-len(addedFieldSets)]
+
+		if c.registered {
+			c.refreshCurrentSnapshot()
+		}
 	}
 
 	return errs
@@ -120,125 +195,170 @@ func (c *AppConfig) AddField(fieldSetKey string, field *Field) []error {
 		return validationErrors
 	}
 
-	if err := c.checkForFieldDependencies(field, fieldSet); err != nil {
+	if errs := c.checkForAliasConflicts(fieldSetKey, Fields{field}); len(errs) > 0 {
+		return errs
+	}
+
+	if err := c.checkForFieldDependencies(field, fieldSet, true); err != nil {
 		return []error{fmt.Errorf("field dependency error: %w", err)}
 	}
 
-	c.fieldSets[fieldSetKey].fieldMap[field.Key] = field
+	_, existingCycleErrs, _ := c.fieldSetLoadOrder()
 
-	return nil
-}
+	field.profile = c.profile
+	fieldSet.addField(field)
 
-func (c *AppConfig) LoadFieldSet(fieldSetKey string) []error {
-	errs := []error{}
+	if _, cycleErrs, _ := c.fieldSetLoadOrder(); len(cycleErrs) > len(existingCycleErrs) {
+		fieldSet.removeField(field.Key)
 
-	if !c.registered {
-		errs = append(errs, fmt.Errorf("LoadFieldSet cannot be called before the app-config has been registered"))
-		return errs
+		return []error{fmt.Errorf("field dependency error: %w", cycleErrs[len(cycleErrs)-1])}
 	}
 
-	return c.loadFieldSet(fieldSetKey)
+	c.generation++
+
+	if c.registered {
+		c.refreshCurrentSnapshot()
+	}
+
+	return nil
 }
 
-func (c *AppConfig) LoadField(fieldSetKey, fieldKey string) []error {
-	errs := []error{}
+func (c *AppConfig) LoadFieldSet(fieldSetKey string) []error {
+	return c.updateFields(func() []error {
+		if !c.registered {
+			return []error{
+				fmt.Errorf("LoadFieldSet cannot be called before the app-config has been registered"),
+			}
+		}
 
-	if !c.registered {
-		errs = append(errs, fmt.Errorf("LoadField cannot be called before the app-config has been registered"))
-		return errs
-	}
+		return c.loadFieldSet(fieldSetKey)
+	})
+}
 
-	if _, fieldSetFound := c.fieldSets[fieldSetKey]; !fieldSetFound {
-		errs = append(errs, fmt.Errorf("field-set with key '%s' not found", fieldSetKey))
-		return errs
-	}
+func (c *AppConfig) LoadField(fieldSetKey, fieldKey string) []error {
+	return c.updateFields(func() []error {
+		errs := []error{}
 
-	field, fieldKeyFound := c.fieldSets[fieldSetKey].fieldMap[fieldKey]
-	if !fieldKeyFound {
-		errs = append(errs, fmt.Errorf("field with key '%s' not found", fieldKey))
-		return errs
-	}
+		if !c.registered {
+			errs = append(errs, fmt.Errorf("LoadField cannot be called before the app-config has been registered"))
+			return errs
+		}
 
-	if load, err := c.shouldLoadField(field, fieldSetKey); err != nil {
-		errs = append(errs, err)
-		return errs
-	} else if !load {
-		errs = append(errs, fmt.Errorf("field load-conditions not met"))
-		return errs
-	}
+		if _, fieldSetFound := c.fieldSets[fieldSetKey]; !fieldSetFound {
+			errs = append(errs, fmt.Errorf("field-set with key '%s' not found", fieldSetKey))
+			return errs
+		}
 
-	for _, loader := range c.loaders {
-		value, found := loader.Get(fieldSetKey, fieldKey)
-		if !found {
-			continue
+		field, fieldKeyFound := c.fieldSets[fieldSetKey].fieldMap[fieldKey]
+		if !fieldKeyFound {
+			errs = append(errs, fmt.Errorf("field with key '%s' not found", fieldKey))
+			return errs
 		}
 
-		if err := field.set(loader.Name(), value); err != nil {
-			errs = append(errs, fmt.Errorf("field '%s' load error: %w", fieldKey, err))
+		if load, err := c.shouldLoadField(field, fieldSetKey); err != nil {
+			errs = append(errs, err)
+			return errs
+		} else if !load {
+			errs = append(errs, fmt.Errorf("field load-conditions not met"))
+			return errs
 		}
-	}
 
-	return nil
+		return c.applyFieldSourceValues(fieldSetKey, field, c.fieldSourceValues(fieldSetKey, field))
+	})
 }
 
 func (c *AppConfig) SetField(fieldSetKey, fieldKey string, fieldValue any) error {
-	fieldSet, fieldSetFound := c.fieldSets[fieldSetKey]
-	if !fieldSetFound {
-		return fmt.Errorf("field-set with key '%s' not found", fieldSetKey)
-	}
+	errs := c.updateFields(func() []error {
+		fieldSet, fieldSetFound := c.fieldSets[fieldSetKey]
+		if !fieldSetFound {
+			return []error{fmt.Errorf("field-set with key '%s' not found", fieldSetKey)}
+		}
 
-	field, fieldKeyFound := fieldSet.fieldMap[fieldKey]
-	if !fieldKeyFound {
-		return fmt.Errorf("field with key '%s' not found", fieldKey)
-	}
+		field, fieldKeyFound := fieldSet.fieldMap[fieldKey]
+		if !fieldKeyFound {
+			return []error{fmt.Errorf("field with key '%s' not found", fieldKey)}
+		}
 
-	if err := field.setOverride(fieldValue); err != nil {
-		return fmt.Errorf("problem setting field value: %w", err)
+		if err := field.setOverride(fieldValue); err != nil {
+			return []error{fmt.Errorf("problem setting field value: %w", err)}
+		}
+
+		return nil
+	})
+
+	if len(errs) > 0 {
+		return errs[0]
 	}
 
 	return nil
 }
 
-// Register loads all defined field sets and optionally checks for and handles the help flag -h and --help.
+// Register loads all defined field sets and optionally checks for and handles the help flag -h and --help, found
+// anywhere in the program arguments. The help flag can be followed by the key of an added field-set to print the help
+// of a single field-set, other arguments following it are ignored, and --help=json prints the help model as JSON.
+// Text help is wrapped to the terminal width.
+// Field-sets are loaded after the field-sets their load conditions depend on. Errors from every field-set are returned
+// in load order, and field-sets depending on a field-set with errors are skipped. Use SetFailFast to return after the
+// first field-set with errors instead. When print-config flag handling is enabled with SetHandlePrintConfigFlag, the
+// configuration report is printed after loading when the --print-config flag is found, and the program exits.
 func (c *AppConfig) Register(handleHelpFlag bool) []error {
-	if handleHelpFlag && len(os.Args) > 1 && (os.Args[1] == "--help" || os.Args[1] == "-h") {
-		c.printHelpString()
-		os.Exit(0)
+	if handleHelpFlag {
+		c.fieldSetLock.RLock()
+		fieldSetKeys := c.fieldSetKeys()
+		c.fieldSetLock.RUnlock()
+
+		if format, fieldSetKey, found := helpFlagRequest(os.Args[1:], fieldSetKeys); found {
+			c.printHelp(format, fieldSetKey)
+		}
 	}
 
-	errs := []error{}
+	errs := c.loadAndRegister()
+	c.notifyWarnings()
 
-	for _, fieldSet := range c.orderedFieldSets {
-		if fieldSetErrs := c.loadFieldSet(fieldSet.Key); len(fieldSetErrs) > 0 {
-			errs = append(errs, fieldSetErrs...)
-			return errs
-		}
+	if format, found := c.printConfigFlagFormat(); found {
+		c.printConfigReport(format, errs)
 	}
 
-	c.registered = true
+	return errs
+}
 
-	return nil
+// SetHandlePrintConfigFlag sets whether Register handles the --print-config flag, printing the configuration report
+// (--print-config or --print-config=text for a text table, --print-config=json for JSON) and exiting.
+func (c *AppConfig) SetHandlePrintConfigFlag(handle bool) {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	c.handlePrintConfigFlag = handle
 }
 
+// HelpString returns the help output rendered by the help renderer set with SetHelpRenderer, or by
+// DefaultHelpTemplate. When the help renderer returns an error, the error is passed to the warning handler and the
+// help output is rendered by DefaultHelpTemplate instead.
 func (c *AppConfig) HelpString() string {
-	builder := strings.Builder{}
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
 
-	if c.appName != "" {
-		builder.WriteString(fmt.Sprintf("Usage of '%s':\n", c.appName))
-	} else {
-		builder.WriteString(fmt.Sprintf("Usage of '%s':\n", os.Args[0]))
-	}
+	return c.renderHelp(c.helpModel(""))
+}
 
-	if c.appDescription != "" {
-		builder.WriteString(fmt.Sprintf("%s\n\n", c.appDescription))
-	}
+// FieldSetHelpString returns the help output of a single field-set, rendered the same way as HelpString, including the
+// fallback to DefaultHelpTemplate when the help renderer returns an error. An error is only returned when the
+// field-set is not found.
+func (c *AppConfig) FieldSetHelpString(fieldSetKey string) (string, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
 
-	c.addFieldsToBuilder(&builder)
+	if _, found := c.fieldSets[fieldSetKey]; !found {
+		return "", c.unknownHelpFieldSetError(fieldSetKey)
+	}
 
-	return builder.String()
+	return c.renderHelp(c.helpModel(fieldSetKey)), nil
 }
 
 func (c *AppConfig) ConfigMap() map[string]map[string]any {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
 	configMap := map[string]map[string]any{}
 
 	for _, fieldSet := range c.fieldSets {
@@ -252,7 +372,7 @@ func (c *AppConfig) ConfigMap() map[string]map[string]any {
 			}
 
 			if field.Sensitive {
-				fieldSetMap[field.Key] = "<sensitive-value>"
+				fieldSetMap[field.Key] = sensitiveValuePlaceholder
 				continue
 			}
 
@@ -273,6 +393,9 @@ func (c *AppConfig) ConfigMap() map[string]map[string]any {
 }
 
 func (c *AppConfig) GetFieldSetKeys() []string {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
 	keys := make([]string, len(c.fieldSets))
 	idx := 0
 
@@ -285,34 +408,22 @@ func (c *AppConfig) GetFieldSetKeys() []string {
 }
 
 func (c *AppConfig) GetFieldSetFieldKeys(fieldSetKey string) ([]string, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
 	fieldSet, found := c.fieldSets[fieldSetKey]
 	if !found {
 		return nil, fmt.Errorf("field-set not found with key: '%s'", fieldSetKey)
 	}
 
-	keys := make([]string, len(fieldSet.fieldMap))
-	idx := 0
-
-	for key := range c.fieldSets {
-		keys[idx] = key
-		idx++
-	}
-
-	return keys, nil
+	return fieldSet.fieldKeys(), nil
 }
 
 func (c *AppConfig) GetField(fieldSetKey, fieldKey string) (*Field, error) {
-	fieldSet, found := c.fieldSets[fieldSetKey]
-	if !found {
-		return nil, fmt.Errorf("field-set not found with key '%s'", fieldSetKey)
-	}
-
-	field, found := fieldSet.fieldMap[fieldKey]
-	if !found {
-		return nil, fmt.Errorf("field-set field not found with key '%s'", fieldKey)
-	}
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
 
-	return field, nil
+	return c.getField(fieldSetKey, fieldKey)
 }
 
 func (c *AppConfig) GetString(fieldSetKey, fieldKey string) (string, error) {
@@ -425,90 +536,43 @@ func (c *AppConfig) GetDurations(fieldSetKey, fieldKey string) ([]time.Duration,
 	return val, nil
 }
 
-func (c *AppConfig) FillStruct(configStruct any) (err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("problem filling struct: %s", r)
-		}
-	}()
-
-	if reflect.TypeOf(configStruct).Kind() != reflect.Pointer {
-		return fmt.Errorf("FillStruct expects a pointer to a struct, found '%s'", reflect.TypeOf(configStruct).Kind())
-	}
-
-	configStructValue := reflect.Indirect(reflect.ValueOf(configStruct))
-	configStructType := configStructValue.Type()
-
-	if configStructValue.Kind() != reflect.Struct {
-		return fmt.Errorf("FillStruct expects a pointer to a struct, found pointer to '%s'", configStructValue.Kind())
-	}
-
-	configStructField := configStructValue.FieldByName("ConfigStruct")
-	if !configStructField.IsValid() || configStructField.Type().PkgPath() != "github.com/rheisen/bconf" {
-		return fmt.Errorf("FillStruct expects a struct with a bconf.ConfigStruct field, none found")
-	}
-
-	configStructFieldType, _ := configStructType.FieldByName("ConfigStruct")
-
-	baseFieldSet := configStructFieldType.Tag.Get("bconf")
-
-	if overrideValue := configStructField.FieldByName("FieldSet"); overrideValue.String() != "" {
-		baseFieldSet = overrideValue.String()
-	}
-
-	for i := 0; i < configStructValue.NumField(); i++ {
-		field := configStructType.Field(i)
-
-		if field.Name == "ConfigStruct" && field.Type.PkgPath() == "github.com/rheisen/bconf" {
-			continue
-		}
-
-		fieldTagValue := field.Tag.Get("bconf")
-		fieldKey := ""
-		fieldSetKey := baseFieldSet
-
-		switch fieldTagValue {
-		case "":
-			fieldKey = field.Name
-		case "-":
-			continue
-		default:
-			fieldTagParams := strings.Split(fieldTagValue, ",")
-			fieldLocation := strings.Split(fieldTagParams[0], ".")
-
-			fieldKey = fieldLocation[0]
-
-			// NOTE: error if fieldLocation format isn't <field>.<field-name> ?
-			if len(fieldLocation) > 1 {
-				fieldSetKey = fieldLocation[0]
-				fieldKey = fieldLocation[1]
-			}
-		}
-
-		if fieldSetKey == "" {
-			return fmt.Errorf("unidentified field-set for field: %s", fieldKey)
-		}
+func (c *AppConfig) FillStruct(configStruct any) error {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
 
-		appConfigField, err := c.GetField(fieldSetKey, fieldKey)
+	return fillStruct(configStruct, c.fieldSetKeys(), func(fieldSetKey, fieldKey string) (any, bool, error) {
+		field, err := c.getField(fieldSetKey, fieldKey)
 		if err != nil {
-			return fmt.Errorf("problem getting field '%s.%s': %w", fieldSetKey, fieldKey, err)
+			return nil, false, err
 		}
 
-		val, err := appConfigField.getValue()
+		val, err := field.getValue()
 		if err != nil && err.Error() == emptyFieldError {
-			continue
+			return nil, false, nil
 		} else if err != nil {
-			return fmt.Errorf("problem getting field '%s.%s' value: %w", fieldSetKey, fieldKey, err)
+			return nil, false, err
 		}
 
-		configStructValue.Field(i).Set(reflect.ValueOf(val))
+		return val, true, nil
+	})
+}
+
+// -- Private methods --
+
+func (c *AppConfig) loadAndRegister() []error {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	if errs := c.loadFieldSets(); len(errs) > 0 {
+		return errs
 	}
 
+	c.registered = true
+	c.refreshCurrentSnapshot()
+
 	return nil
 }
 
-// -- Private methods --
-
 func (c *AppConfig) addFieldSet(fieldSet *FieldSet, lock bool) []error {
 	if lock {
 		c.fieldSetLock.Lock()
@@ -527,7 +591,11 @@ func (c *AppConfig) addFieldSet(fieldSet *FieldSet, lock bool) []error {
 
 	fieldSet.initializeFieldMap()
 
-	if errs := c.checkForFieldSetDependencies(fieldSet); len(errs) > 0 {
+	if errs := c.checkForAliasConflicts(fieldSet.Key, fieldSet.orderedFields()); len(errs) > 0 {
+		return errs
+	}
+
+	if errs := c.checkForFieldSetInternalDependencies(fieldSet); len(errs) > 0 {
 		return errs
 	}
 
@@ -540,9 +608,15 @@ func (c *AppConfig) addFieldSet(fieldSet *FieldSet, lock bool) []error {
 	}
 
 	fieldSet.Fields = nil
+	fieldSet.setProfile(c.profile)
 
 	c.fieldSets[fieldSet.Key] = fieldSet
 	c.orderedFieldSets = append(c.orderedFieldSets, fieldSet)
+	c.generation++
+
+	if c.registered {
+		c.refreshCurrentSnapshot()
+	}
 
 	return nil
 }
@@ -559,69 +633,141 @@ func (c *AppConfig) checkForFieldSetStructuralIntegrity(fieldSet *FieldSet) []er
 	return errs
 }
 
-func (c *AppConfig) checkForFieldSetDependencies(fieldSet *FieldSet) []error {
+// checkForAliasConflicts returns errors for field aliases that refer to a field in another field-set, and for aliases
+// of registered fields that refer to one of the fields being added, which would otherwise shadow the field value.
+func (c *AppConfig) checkForAliasConflicts(fieldSetKey string, fields Fields) []error {
 	errs := []error{}
+	fieldKeys := make(map[string]struct{}, len(fields))
 
-	for _, loadCondition := range fieldSet.LoadConditions {
-		fieldSetKey, fieldKey := loadCondition.FieldDependency()
-		if fieldSetKey == "" && fieldKey == "" {
+	for _, field := range fields {
+		fieldKeys[field.Key] = struct{}{}
+
+		for _, alias := range field.Aliases {
+			aliasFieldSetKey, aliasFieldKey := field.aliasLocation(alias, fieldSetKey)
+			if aliasFieldSetKey == fieldSetKey {
+				continue
+			}
+
+			if aliasFieldSet, found := c.fieldSets[aliasFieldSetKey]; found {
+				if _, found := aliasFieldSet.fieldMap[aliasFieldKey]; found {
+					errs = append(errs, fmt.Errorf(
+						"field '%s_%s' alias conflicts with field: '%s'", fieldSetKey, field.Key, alias,
+					))
+				}
+			}
+		}
+	}
+
+	for _, registeredFieldSet := range c.orderedFieldSets {
+		if registeredFieldSet.Key == fieldSetKey {
 			continue
 		}
 
-		fieldSetDependency, found := c.fieldSets[fieldSetKey]
-		if !found {
-			errs = append(
-				errs,
-				fmt.Errorf("field-set '%s' field-set dependency not found: %s", fieldSet.Key, fieldSetKey),
-			)
+		for _, registeredField := range registeredFieldSet.orderedFields() {
+			for _, alias := range registeredField.Aliases {
+				aliasFieldSetKey, aliasFieldKey := registeredField.aliasLocation(alias, registeredFieldSet.Key)
+				if _, found := fieldKeys[aliasFieldKey]; found && aliasFieldSetKey == fieldSetKey {
+					errs = append(errs, fmt.Errorf(
+						"field '%s_%s' conflicts with alias of field '%s_%s': '%s'",
+						fieldSetKey,
+						aliasFieldKey,
+						registeredFieldSet.Key,
+						registeredField.Key,
+						alias,
+					))
+				}
+			}
+		}
+	}
 
-			continue
+	return errs
+}
+
+func (c *AppConfig) checkForFieldSetDependencies(fieldSet *FieldSet) []error {
+	errs := []error{}
+
+	for _, loadCondition := range fieldSet.LoadConditions {
+		for _, dependency := range loadConditionDependencies(loadCondition) {
+			fieldSetKey, fieldKey := dependency.FieldSetKey, dependency.FieldKey
+
+			fieldSetDependency, found := c.fieldSets[fieldSetKey]
+			if !found {
+				errs = append(
+					errs,
+					fmt.Errorf("field-set '%s' field-set dependency not found: %s", fieldSet.Key, fieldSetKey),
+				)
+
+				continue
+			}
+
+			_, found = fieldSetDependency.fieldMap[fieldKey]
+			if !found {
+				errs = append(
+					errs,
+					fmt.Errorf(
+						"field-set '%s' field-set dependency field not found: %s_%s",
+						fieldSet.Key, fieldSetKey, fieldKey,
+					),
+				)
+			}
 		}
+	}
 
-		_, found = fieldSetDependency.fieldMap[fieldKey]
-		if !found {
-			errs = append(
-				errs,
-				fmt.Errorf(
-					"field-set '%s' field-set dependency field not found: %s_%s",
-					fieldSet.Key, fieldSetKey, fieldKey,
-				),
-			)
+	for _, field := range fieldSet.orderedFields() {
+		if err := c.checkForFieldDependencies(field, fieldSet, true); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
-	for _, field := range fieldSet.Fields {
-		if err := c.checkForFieldDependencies(field, fieldSet); err != nil {
+	return errs
+}
+
+// checkForFieldSetInternalDependencies checks field load condition dependencies on fields in the same field-set.
+// Dependencies on other field-sets are checked on Register, allowing field-sets to be added in any order.
+func (c *AppConfig) checkForFieldSetInternalDependencies(fieldSet *FieldSet) []error {
+	errs := []error{}
+
+	for _, field := range fieldSet.orderedFields() {
+		if err := c.checkForFieldDependencies(field, fieldSet, false); err != nil {
 			errs = append(errs, err)
 		}
 	}
 
+	if len(errs) > 0 {
+		return errs
+	}
+
+	_, cycleErrs := fieldSet.fieldLoadOrder()
+
+	for _, err := range cycleErrs {
+		errs = append(errs, fmt.Errorf("field-set '%s' %w", fieldSet.Key, err))
+	}
+
 	return errs
 }
 
-func (c *AppConfig) checkForFieldDependencies(field *Field, parent *FieldSet) error {
-	if len(field.LoadConditions) > 0 {
-		for _, loadCondition := range field.LoadConditions {
+func (c *AppConfig) checkForFieldDependencies(field *Field, parent *FieldSet, checkExternal bool) error {
+	for _, loadCondition := range field.LoadConditions {
+		for _, dependency := range loadConditionDependencies(loadCondition) {
 			var fieldSetDependency *FieldSet
 
 			var found bool
 
-			fieldSetKey, fieldKey := loadCondition.FieldDependency()
-
-			if fieldSetKey == "" && fieldKey == "" {
-				continue
-			}
+			fieldSetKey, fieldKey := dependency.FieldSetKey, dependency.FieldKey
 
-			if fieldSetKey == "" || fieldSetKey == parent.Key {
+			switch {
+			case fieldSetKey == "" || fieldSetKey == parent.Key:
 				fieldSetKey = parent.Key
 				fieldSetDependency = parent
-			} else {
+			case !checkExternal:
+				continue
+			default:
 				fieldSetDependency, found = c.fieldSets[fieldSetKey]
 
 				if !found {
 					return fmt.Errorf(
 						"field-set '%s' field '%s' field-set dependency not found: %s",
-						fieldSetKey, field.Key, fieldSetKey,
+						parent.Key, field.Key, fieldSetKey,
 					)
 				}
 			}
@@ -668,6 +814,61 @@ func (c *AppConfig) checkForFieldSetFieldsValidity(fieldSet *FieldSet) []error {
 	return errs
 }
 
+// loadFieldSets loads every field-set in dependency order, returning errors from every field-set (or from the first
+// field-set with errors when fail-fast is set).
+func (c *AppConfig) loadFieldSets() []error {
+	if err := c.checkProfileField(); err != nil {
+		return []error{err}
+	}
+
+	errs := []error{}
+	failedFieldSets := map[string]struct{}{}
+	reportedErrs := map[error]struct{}{}
+	loadOrder, _, invalidFieldSets := c.fieldSetLoadOrder()
+
+	for _, fieldSet := range loadOrder {
+		if fieldSetErrs, invalid := invalidFieldSets[fieldSet.Key]; invalid {
+			// a cycle error is shared by every field-set in the cycle, and is only reported once
+			for _, err := range fieldSetErrs {
+				if _, reported := reportedErrs[err]; !reported {
+					reportedErrs[err] = struct{}{}
+					errs = append(errs, err)
+				}
+			}
+
+			failedFieldSets[fieldSet.Key] = struct{}{}
+
+			if c.failFast {
+				return errs
+			}
+
+			continue
+		}
+
+		if dependencyKey, failed := c.failedFieldSetDependency(fieldSet, failedFieldSets); failed {
+			errs = append(errs, fmt.Errorf(
+				"field-set '%s' not loaded: field-set dependency '%s' has errors",
+				fieldSet.Key,
+				dependencyKey,
+			))
+			failedFieldSets[fieldSet.Key] = struct{}{}
+
+			continue
+		}
+
+		if fieldSetErrs := c.loadFieldSet(fieldSet.Key); len(fieldSetErrs) > 0 {
+			errs = append(errs, fieldSetErrs...)
+			failedFieldSets[fieldSet.Key] = struct{}{}
+
+			if c.failFast {
+				return errs
+			}
+		}
+	}
+
+	return append(errs, c.unknownSourceKeyErrors()...)
+}
+
 func (c *AppConfig) loadFieldSet(fieldSetKey string) []error {
 	errs := []error{}
 
@@ -683,39 +884,59 @@ func (c *AppConfig) loadFieldSet(fieldSetKey string) []error {
 		return errs
 	}
 
-	for _, loader := range c.loaders {
-		values := loader.GetMap(fieldSetKey, c.fieldSets[fieldSetKey].fieldKeys())
-		for key, value := range values {
-			field := c.fieldSets[fieldSetKey].fieldMap[key]
+	// Values are gathered from every loader before field load conditions are evaluated, and fields are applied after
+	// the fields they depend on, so conditions see values with loader precedence applied regardless of loader order.
+	sourceValues := c.fieldSetSourceValues(fieldSet)
 
-			if load, err := c.shouldLoadField(field, fieldSetKey); err != nil {
-				errs = append(errs, err)
-				continue
-			} else if !load {
-				continue
-			}
+	for _, field := range fieldSet.dependencyOrderedFields() {
+		fieldSourceValues := sourceValues[field.Key]
+		if len(fieldSourceValues) < 1 {
+			field.clearLoaderValues()
+			continue
+		}
 
-			if err := c.fieldSets[fieldSetKey].fieldMap[key].set(loader.Name(), value); err != nil {
-				errs = append(errs, fmt.Errorf("field '%s' load error: %w", key, err))
-			}
+		if load, err := c.shouldLoadField(field, fieldSetKey); err != nil {
+			errs = append(errs, err)
+			continue
+		} else if !load {
+			field.clearLoaderValues()
+			continue
 		}
+
+		errs = append(errs, c.applyFieldSourceValues(fieldSetKey, field, fieldSourceValues)...)
 	}
 
-	for _, field := range fieldSet.fieldMap {
-		if field.Required && len(field.LoadConditions) < 1 {
-			if _, err := field.getValue(); err != nil {
-				errs = append(errs, fmt.Errorf("required field '%s_%s' not set", fieldSet.Key, field.Key))
-			}
-		} else if field.Required {
+	if fieldSet.Key == c.profileFieldSet {
+		c.setProfile()
+	}
+
+	for _, field := range fieldSet.orderedFields() {
+		if !field.requiredInProfile() {
+			continue
+		}
+
+		_, err := field.getValue()
+
+		switch {
+		case err == nil:
+			continue
+		case len(field.LoadConditions) > 0:
 			if load, _ := c.shouldLoadField(field, fieldSet.Key); load {
-				if _, err := field.getValue(); err != nil {
-					errs = append(errs, fmt.Errorf(
-						"conditionally required field '%s_%s' load condition met, but field value not set",
-						fieldSet.Key,
-						field.Key,
-					))
-				}
+				errs = append(errs, fmt.Errorf(
+					"conditionally required field '%s_%s' load condition met, but field value not set",
+					fieldSet.Key,
+					field.Key,
+				))
 			}
+		case field.Required:
+			errs = append(errs, fmt.Errorf("required field '%s_%s' not set", fieldSet.Key, field.Key))
+		default:
+			errs = append(errs, fmt.Errorf(
+				"required field '%s_%s' not set in profile '%s'",
+				fieldSet.Key,
+				field.Key,
+				c.profile,
+			))
 		}
 	}
 
@@ -723,303 +944,614 @@ func (c *AppConfig) loadFieldSet(fieldSetKey string) []error {
 }
 
 func (c *AppConfig) shouldLoadFieldSet(fieldSet *FieldSet) (bool, error) {
-	loadFieldSet := true
+	if !fieldSet.loadedInProfile(c.profile) {
+		return false, nil
+	}
 
-	if len(fieldSet.LoadConditions) > 0 {
-		for _, loadCondition := range fieldSet.LoadConditions {
-			if !loadFieldSet {
-				break
-			}
+	for _, loadCondition := range fieldSet.LoadConditions {
+		if load, err := c.loadConditionOutcome(loadCondition, ""); err != nil || !load {
+			return false, err
+		}
+	}
 
-			conditionFieldSetKey, conditionFieldSetFieldKey := loadCondition.FieldDependency()
-			if conditionFieldSetKey != "" && conditionFieldSetFieldKey != "" {
-				fieldValue, err := c.getFieldValue(conditionFieldSetKey, conditionFieldSetFieldKey, "any")
-				if err != nil {
-					return false, fmt.Errorf("problem getting field value for load condition: %w", err)
-				}
+	return true, nil
+}
 
-				loadFieldSet, err = loadCondition.Load(fieldValue)
-				if err != nil {
-					return false, fmt.Errorf("problem getting load condition outcome: %w", err)
-				}
+func (c *AppConfig) shouldLoadField(field *Field, fieldSetKey string) (bool, error) {
+	for _, loadCondition := range field.LoadConditions {
+		if load, err := c.loadConditionOutcome(loadCondition, fieldSetKey); err != nil || !load {
+			return false, err
+		}
+	}
 
-				continue
-			}
+	return true, nil
+}
 
-			var err error
+// loadConditionOutcome evaluates a load condition, where field dependencies without a field-set key refer to the
+// parent field-set.
+func (c *AppConfig) loadConditionOutcome(loadCondition LoadCondition, parentFieldSetKey string) (bool, error) {
+	if multiFieldCondition, ok := loadCondition.(MultiFieldLoadCondition); ok {
+		values := map[FieldDependency]any{}
 
-			loadFieldSet, err = loadCondition.Load(nil)
+		for _, dependency := range multiFieldCondition.FieldDependencies() {
+			fieldSetKey := dependency.FieldSetKey
+			if fieldSetKey == "" {
+				fieldSetKey = parentFieldSetKey
+			}
+
+			field, err := c.getField(fieldSetKey, dependency.FieldKey)
 			if err != nil {
-				return false, fmt.Errorf("problem getting load condition outcome: %w", err)
+				return false, fmt.Errorf("problem getting field value for load condition: %w", err)
 			}
 
-			continue
+			if fieldValue, err := field.getValue(); err == nil {
+				values[dependency] = fieldValue
+			}
+		}
+
+		load, err := multiFieldCondition.LoadValues(values)
+		if err != nil {
+			return false, fmt.Errorf("problem getting load condition outcome: %w", err)
+		}
+
+		return load, nil
+	}
+
+	conditionFieldSetKey, conditionFieldSetFieldKey := loadCondition.FieldDependency()
+	if conditionFieldSetKey == "" {
+		conditionFieldSetKey = parentFieldSetKey
+	}
+
+	var fieldValue any
+
+	if conditionFieldSetKey != "" && conditionFieldSetFieldKey != "" {
+		var err error
+
+		fieldValue, err = c.lookupFieldValue(conditionFieldSetKey, conditionFieldSetFieldKey, "any")
+		if err != nil {
+			return false, fmt.Errorf("problem getting field value for load condition: %w", err)
 		}
 	}
 
-	return loadFieldSet, nil
+	load, err := loadCondition.Load(fieldValue)
+	if err != nil {
+		return false, fmt.Errorf("problem getting load condition outcome: %w", err)
+	}
+
+	return load, nil
 }
 
-func (c *AppConfig) shouldLoadField(field *Field, fieldSetKey string) (bool, error) {
-	loadField := true
+// fieldSourceValue is a raw field value found by a loader, either with the field key or with a field alias.
+type fieldSourceValue struct {
+	loader Loader
+	value  string
+	alias  string
+}
 
-	if len(field.LoadConditions) > 0 {
-		for _, loadCondition := range field.LoadConditions {
-			if !loadField {
-				break
-			}
+// fieldSetSourceValues returns the raw values found by every loader for the fields of a field-set, in loader order.
+func (c *AppConfig) fieldSetSourceValues(fieldSet *FieldSet) map[string][]fieldSourceValue {
+	sourceValues := map[string][]fieldSourceValue{}
+	fieldKeys := fieldSet.fieldKeys()
 
-			conditionFieldSetKey, conditionFieldSetFieldKey := loadCondition.FieldDependency()
-			if conditionFieldSetKey == "" {
-				conditionFieldSetKey = fieldSetKey
-			}
+	for _, loader := range c.loaders {
+		values := loader.GetMap(fieldSet.Key, fieldKeys)
 
-			if conditionFieldSetKey != "" && conditionFieldSetFieldKey != "" {
-				fieldValue, err := c.getFieldValue(conditionFieldSetKey, conditionFieldSetFieldKey, "any")
-				if err != nil {
-					return false, fmt.Errorf("problem getting field value for load condition: %w", err)
-				}
+		var aliasValues map[string]map[string]string
 
-				loadField, err = loadCondition.Load(fieldValue)
-				if err != nil {
-					return false, fmt.Errorf("problem getting load condition outcome: %w", err)
+		for _, key := range fieldKeys {
+			field := fieldSet.fieldMap[key]
+			alias := ""
+
+			value, found := values[key]
+			if !found && len(field.Aliases) > 0 {
+				if aliasValues == nil {
+					aliasValues = loaderAliasValues(loader, fieldSet.Key, fieldSet.orderedFields())
 				}
 
-				continue
+				value, alias, found = aliasValue(aliasValues, fieldSet.Key, field)
 			}
 
-			var err error
-
-			loadField, err = loadCondition.Load(nil)
-			if err != nil {
-				return false, fmt.Errorf("problem getting load condition outcome: %w", err)
+			if found {
+				sourceValues[key] = append(sourceValues[key], fieldSourceValue{loader: loader, value: value, alias: alias})
 			}
+		}
+	}
 
+	return sourceValues
+}
+
+// fieldSourceValues returns the raw values found by every loader for a single field, in loader order.
+func (c *AppConfig) fieldSourceValues(fieldSetKey string, field *Field) []fieldSourceValue {
+	sourceValues := []fieldSourceValue{}
+
+	for _, loader := range c.loaders {
+		alias := ""
+
+		value, found := loader.Get(fieldSetKey, field.Key)
+		if !found && len(field.Aliases) > 0 {
+			value, alias, found = aliasValue(loaderAliasValues(loader, fieldSetKey, Fields{field}), fieldSetKey, field)
+		}
+
+		if found {
+			sourceValues = append(sourceValues, fieldSourceValue{loader: loader, value: value, alias: alias})
+		}
+	}
+
+	return sourceValues
+}
+
+// applyFieldSourceValues replaces the field loader values with the values found by loaders.
+func (c *AppConfig) applyFieldSourceValues(fieldSetKey string, field *Field, sourceValues []fieldSourceValue) []error {
+	errs := []error{}
+
+	field.clearLoaderValues()
+
+	for _, sourceValue := range sourceValues {
+		c.warnDeprecatedUse(sourceValue.loader, fieldSetKey, field, sourceValue.alias)
+
+		if err := field.set(sourceValue.loader.Name(), sourceValue.value); err != nil {
+			errs = append(errs, fmt.Errorf("field '%s' load error: %w", field.Key, err))
 			continue
 		}
+
+		sourceFieldSetKey, sourceFieldKey := fieldSetKey, field.Key
+		if sourceValue.alias != "" {
+			sourceFieldSetKey, sourceFieldKey = field.aliasLocation(sourceValue.alias, fieldSetKey)
+		}
+
+		field.setSourceKey(sourceValue.loader.Name(), loaderSourceKey(sourceValue.loader, sourceFieldSetKey, sourceFieldKey))
 	}
 
-	return loadField, nil
+	return errs
 }
 
-func (c *AppConfig) getFieldValue(fieldSetKey, fieldKey, expectedType string) (any, error) {
-	field, err := c.GetField(fieldSetKey, fieldKey)
-	if err != nil {
-		return nil, err
+// loaderAliasValues returns the loader values found for the aliases of fields, keyed by alias field-set key and alias
+// field key. The loader source is read once per alias field-set, rather than once per alias.
+func loaderAliasValues(loader Loader, fieldSetKey string, fields Fields) map[string]map[string]string {
+	aliasFieldKeys := map[string][]string{}
+
+	for _, field := range fields {
+		for _, alias := range field.Aliases {
+			aliasFieldSetKey, aliasFieldKey := field.aliasLocation(alias, fieldSetKey)
+			aliasFieldKeys[aliasFieldSetKey] = append(aliasFieldKeys[aliasFieldSetKey], aliasFieldKey)
+		}
 	}
 
-	if expectedType != "" && expectedType != "any" && field.Type != expectedType {
-		return nil, fmt.Errorf("incorrect field-type for field '%s', found '%s'", fieldKey, field.Type)
+	aliasValues := make(map[string]map[string]string, len(aliasFieldKeys))
+
+	for aliasFieldSetKey, keys := range aliasFieldKeys {
+		aliasValues[aliasFieldSetKey] = loader.GetMap(aliasFieldSetKey, keys)
 	}
 
-	fieldValue, err := field.getValue()
-	if err != nil {
-		return nil, fmt.Errorf("no value set for field '%s'", fieldKey)
+	return aliasValues
+}
+
+// aliasValue checks field aliases in order for a loader value, returning the value and the alias it was found with.
+func aliasValue(aliasValues map[string]map[string]string, fieldSetKey string, field *Field) (string, string, bool) {
+	for _, alias := range field.Aliases {
+		aliasFieldSetKey, aliasFieldKey := field.aliasLocation(alias, fieldSetKey)
+
+		if value, found := aliasValues[aliasFieldSetKey][aliasFieldKey]; found {
+			return value, alias, true
+		}
 	}
 
-	return fieldValue, nil
+	return "", "", false
 }
 
-type fieldEntry struct {
-	fieldSetKey    string
-	field          *Field
-	loadConditions LoadConditions
+// warnDeprecatedUse emits warnings when a loader value was found through a field alias, or for a deprecated field.
+// Warnings are held until notifyWarnings is called, after the field-set lock has been released.
+func (c *AppConfig) warnDeprecatedUse(loader Loader, fieldSetKey string, field *Field, alias string) {
+	if c.warningHandler == nil {
+		return
+	}
+
+	if alias != "" {
+		aliasFieldSetKey, aliasFieldKey := field.aliasLocation(alias, fieldSetKey)
+		aliasKey := loaderSourceKey(loader, aliasFieldSetKey, aliasFieldKey)
+		fieldKey := loaderSourceKey(loader, fieldSetKey, field.Key)
+
+		c.pendingWarnings = append(c.pendingWarnings, fmt.Sprintf(
+			"loader '%s' found deprecated alias '%s' for field '%s_%s', use '%s' instead",
+			loader.Name(),
+			aliasKey,
+			fieldSetKey,
+			field.Key,
+			fieldKey,
+		))
+	}
+
+	if field.Deprecated {
+		warning := fmt.Sprintf("loader '%s' found value for deprecated field '%s_%s'", loader.Name(), fieldSetKey, field.Key)
+		if field.DeprecationMessage != "" {
+			warning = fmt.Sprintf("%s: %s", warning, field.DeprecationMessage)
+		}
+
+		c.pendingWarnings = append(c.pendingWarnings, warning)
+	}
 }
 
-func (c *AppConfig) fields() map[string]*fieldEntry {
-	fields := map[string]*fieldEntry{}
+// notifyWarnings passes the pending warnings to the warning handler. It must be called without holding the field-set
+// lock.
+func (c *AppConfig) notifyWarnings() {
+	c.fieldSetLock.Lock()
+	handler, warnings := c.warningHandler, c.pendingWarnings
+	c.pendingWarnings = nil
+	c.fieldSetLock.Unlock()
 
-	for fieldSetKey, fieldSet := range c.fieldSets {
-		for _, field := range fieldSet.fieldMap {
-			entry := fieldEntry{field: field, fieldSetKey: fieldSetKey}
+	if handler == nil {
+		return
+	}
 
-			if len(fieldSet.LoadConditions) > 0 {
-				entry.loadConditions = fieldSet.LoadConditions
+	for _, warning := range warnings {
+		handler(warning)
+	}
+}
+
+// loaderSourceKey returns the key a loader uses to look up a field value, or '<field-set-key>.<field-key>' for loaders
+// that do not implement SourceKeyLoader.
+func loaderSourceKey(loader Loader, fieldSetKey, fieldKey string) string {
+	if sourceKeyLoader, ok := loader.(SourceKeyLoader); ok {
+		return sourceKeyLoader.SourceKey(fieldSetKey, fieldKey)
+	}
+
+	return fmt.Sprintf("%s.%s", fieldSetKey, fieldKey)
+}
+
+// unknownSourceKeyErrors returns an error for every key found by a strict loader that does not map to a registered
+// field, with a suggestion when a registered field key is similar.
+func (c *AppConfig) unknownSourceKeyErrors() []error {
+	errs := []error{}
+
+	for _, loader := range c.loaders {
+		sourceKeyLoader, ok := loader.(SourceKeyLoader)
+		if !ok || (!c.strict && !sourceKeyLoader.StrictMode()) {
+			continue
+		}
+
+		knownKeys := []string{}
+		knownKeyMap := map[string]struct{}{}
+
+		for _, fieldSet := range c.orderedFieldSets {
+			for _, field := range fieldSet.orderedFields() {
+				sourceKey := sourceKeyLoader.SourceKey(fieldSet.Key, field.Key)
+				knownKeys = append(knownKeys, sourceKey)
+				knownKeyMap[sourceKey] = struct{}{}
+
+				for _, alias := range field.Aliases {
+					knownKeyMap[sourceKeyLoader.SourceKey(field.aliasLocation(alias, fieldSet.Key))] = struct{}{}
+				}
+			}
+		}
+
+		sourceKeys := sourceKeyLoader.SourceKeys()
+		sort.Strings(sourceKeys)
+
+		for _, sourceKey := range sourceKeys {
+			if _, found := knownKeyMap[sourceKey]; found {
+				continue
 			}
 
-			if len(field.LoadConditions) > 0 {
-				entry.loadConditions = append(entry.loadConditions, field.LoadConditions...)
+			if suggestion := closestKey(sourceKey, knownKeys); suggestion != "" {
+				errs = append(errs, fmt.Errorf(
+					"unknown key '%s' found by loader '%s' (did you mean '%s'?)",
+					sourceKey,
+					loader.Name(),
+					suggestion,
+				))
+
+				continue
 			}
 
-			fields[fmt.Sprintf("%s_%s", fieldSetKey, field.Key)] = &entry
+			errs = append(errs, fmt.Errorf("unknown key '%s' found by loader '%s'", sourceKey, loader.Name()))
 		}
 	}
 
-	return fields
+	return errs
 }
 
-func (c *AppConfig) addFieldsToBuilder(builder *strings.Builder) {
-	fields := c.fields()
-	if len(fields) > 0 {
-		keys := make([]string, len(fields))
-		idx := 0
+// checkProfileField returns an error if a profile field has been set that does not refer to a valid profile field.
+func (c *AppConfig) checkProfileField() error {
+	if c.profileFieldSet == "" && c.profileField == "" {
+		return nil
+	}
 
-		for key := range fields {
-			keys[idx] = key
-			idx++
-		}
+	fieldSet, fieldSetFound := c.fieldSets[c.profileFieldSet]
+	if !fieldSetFound {
+		return fmt.Errorf("profile field-set with key '%s' not found", c.profileFieldSet)
+	}
 
-		sort.Strings(keys)
+	field, fieldFound := fieldSet.fieldMap[c.profileField]
+
+	switch {
+	case !fieldFound:
+		return fmt.Errorf("profile field with key '%s' not found in field-set '%s'", c.profileField, fieldSet.Key)
+	case field.Type != String:
+		return fmt.Errorf("invalid profile field type: expected '%s', found '%s'", String, field.Type)
+	case len(field.ProfileDefaults) > 0 || len(field.RequiredInProfiles) > 0:
+		return fmt.Errorf("invalid profile field: profile field cannot define ProfileDefaults or RequiredInProfiles")
+	case len(fieldSet.Profiles) > 0:
+		return fmt.Errorf("invalid profile field-set: profile field-set cannot define Profiles")
+	}
 
-		conditionallyRequiredFields := []string{}
-		requiredFields := []string{}
-		optionalFields := []string{}
+	return nil
+}
 
-		for _, key := range keys {
-			fieldEntry := fields[key]
+// setProfile sets the active profile from the profile field value, and applies it to every field.
+func (c *AppConfig) setProfile() {
+	c.profile = ""
 
-			switch {
-			case fieldEntry.field.Required && fieldEntry.loadConditions == nil:
-				requiredFields = append(requiredFields, key)
-			case fieldEntry.field.Required && fieldEntry.loadConditions != nil:
-				conditionallyRequiredFields = append(conditionallyRequiredFields, key)
-			default:
-				optionalFields = append(optionalFields, key)
+	if fieldSet, found := c.fieldSets[c.profileFieldSet]; found {
+		if field, found := fieldSet.fieldMap[c.profileField]; found {
+			if value, err := field.getValue(); err == nil {
+				c.profile, _ = value.(string)
 			}
 		}
+	}
 
-		if len(requiredFields) > 0 {
-			builder.WriteString("Required Configuration:\n")
+	for _, fieldSet := range c.fieldSets {
+		fieldSet.setProfile(c.profile)
+	}
+}
 
-			for _, key := range requiredFields {
-				fmt.Fprintf(builder, "\t%s", c.fieldHelpString(fields, key))
-			}
+// fieldSetDependencies returns the keys of field-sets referenced by the load conditions of a field-set and its fields,
+// in the order they are declared. Field load conditions referencing fields in the same field-set are not included,
+// while field-set load conditions referencing the field-set itself are. When a profile field is set, every other
+// field-set depends on the field-set containing it.
+func (c *AppConfig) fieldSetDependencies(fieldSet *FieldSet) []string {
+	dependencies := []string{}
+	seen := map[string]struct{}{}
+
+	addDependency := func(fieldSetKey string) {
+		if _, found := seen[fieldSetKey]; found || fieldSetKey == "" {
+			return
 		}
 
-		if len(conditionallyRequiredFields) > 0 {
-			builder.WriteString("Conditionally Required Configuration:\n")
+		seen[fieldSetKey] = struct{}{}
+		dependencies = append(dependencies, fieldSetKey)
+	}
+
+	if _, found := c.fieldSets[c.profileFieldSet]; found && fieldSet.Key != c.profileFieldSet {
+		addDependency(c.profileFieldSet)
+	}
+
+	for _, loadCondition := range fieldSet.LoadConditions {
+		for _, dependency := range loadConditionDependencies(loadCondition) {
+			addDependency(dependency.FieldSetKey)
+		}
+	}
 
-			for _, key := range conditionallyRequiredFields {
-				fmt.Fprintf(builder, "\t%s", c.fieldHelpString(fields, key))
+	for _, field := range fieldSet.orderedFields() {
+		for _, loadCondition := range field.LoadConditions {
+			for _, dependency := range loadConditionDependencies(loadCondition) {
+				if dependency.FieldSetKey != fieldSet.Key {
+					addDependency(dependency.FieldSetKey)
+				}
 			}
 		}
+	}
+
+	return dependencies
+}
 
-		if len(optionalFields) > 0 {
-			builder.WriteString("Optional Configuration:\n")
+// fieldSetLoadOrder returns the field-sets ordered so that every field-set follows the field-sets it depends on, and
+// otherwise keeps the order field-sets were added in. Field-sets with missing dependencies, or that are part of a
+// dependency cycle, are mapped to their errors in the invalid return value. Cycle errors are also returned separately.
+func (c *AppConfig) fieldSetLoadOrder() (loadOrder FieldSets, cycleErrs []error, invalid map[string][]error) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	loadOrder = FieldSets{}
+	cycleErrs = []error{}
+	invalid = map[string][]error{}
+	state := map[string]int{}
+	path := []string{}
+	cycles := map[string]struct{}{}
+
+	var visit func(fieldSet *FieldSet)
+	visit = func(fieldSet *FieldSet) {
+		state[fieldSet.Key] = visiting
+		path = append(path, fieldSet.Key)
+
+		if errs := c.checkForFieldSetDependencies(fieldSet); len(errs) > 0 {
+			invalid[fieldSet.Key] = append(invalid[fieldSet.Key], errs...)
+		}
 
-			for _, key := range optionalFields {
-				fmt.Fprintf(builder, "\t%s", c.fieldHelpString(fields, key))
+		for _, dependencyKey := range c.fieldSetDependencies(fieldSet) {
+			dependency, found := c.fieldSets[dependencyKey]
+			if !found {
+				continue
 			}
+
+			switch state[dependencyKey] {
+			case visiting:
+				cycleStart := 0
+				for path[cycleStart] != dependencyKey {
+					cycleStart++
+				}
+
+				cycle := path[cycleStart:]
+				if _, found := cycles[normalizedCycle(cycle)]; found {
+					continue
+				}
+
+				cycles[normalizedCycle(cycle)] = struct{}{}
+				err := fmt.Errorf(
+					"field-set dependency cycle detected: %s",
+					strings.Join(append(append([]string{}, cycle...), dependencyKey), " -> "),
+				)
+				cycleErrs = append(cycleErrs, err)
+
+				for _, fieldSetKey := range cycle {
+					invalid[fieldSetKey] = append(invalid[fieldSetKey], err)
+				}
+			case visited:
+				continue
+			default:
+				visit(dependency)
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[fieldSet.Key] = visited
+		loadOrder = append(loadOrder, fieldSet)
+	}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		if state[fieldSet.Key] == 0 {
+			visit(fieldSet)
 		}
 	}
+
+	return loadOrder, cycleErrs, invalid
 }
 
-func (c *AppConfig) fieldHelpString(fields map[string]*fieldEntry, key string) string {
-	entry := fields[key]
-	field := entry.field
-	loadConditions := entry.loadConditions
+// normalizedCycle returns the keys of a dependency cycle rotated to start at the smallest key, identifying the cycle
+// regardless of the key it was entered from.
+func normalizedCycle(cycle []string) string {
+	start := 0
 
-	if field == nil {
-		return "no field matching key"
+	for index, key := range cycle {
+		if key < cycle[start] {
+			start = index
+		}
 	}
 
-	builder := strings.Builder{}
-	spaceBuffer := "\t\t"
-
-	builder.WriteString(fmt.Sprintf("%s %s\n", key, field.Type))
+	return strings.Join(append(append([]string{}, cycle[start:]...), cycle[:start]...), " -> ")
+}
 
-	if field.Description != "" {
-		builder.WriteString(spaceBuffer)
-		builder.WriteString(fmt.Sprintf("%s\n", field.Description))
+func (c *AppConfig) failedFieldSetDependency(fieldSet *FieldSet, failedFieldSets map[string]struct{}) (string, bool) {
+	for _, dependencyKey := range c.fieldSetDependencies(fieldSet) {
+		if _, failed := failedFieldSets[dependencyKey]; failed {
+			return dependencyKey, true
+		}
 	}
 
-	if len(field.Enumeration) > 0 {
-		builder.WriteString(spaceBuffer)
-		builder.WriteString(fmt.Sprintf("Accepted values: %s\n", field.enumerationString()))
+	return "", false
+}
+
+func (c *AppConfig) getField(fieldSetKey, fieldKey string) (*Field, error) {
+	fieldSet, found := c.fieldSets[fieldSetKey]
+	if !found {
+		return nil, fmt.Errorf("field-set not found with key '%s'", fieldSetKey)
 	}
 
-	if field.Default != nil && field.Sensitive {
-		builder.WriteString(spaceBuffer)
-		builder.WriteString("Default value: '<sensitive-value>'\n")
-	} else if field.Default != nil {
-		builder.WriteString(spaceBuffer)
-		builder.WriteString(fmt.Sprintf("Default value: '%v'\n", field.Default))
+	field, found := fieldSet.fieldMap[fieldKey]
+	if !found {
+		return nil, fmt.Errorf("field-set field not found with key '%s'", fieldKey)
 	}
 
-	if field.DefaultGenerator != nil {
-		builder.WriteString(spaceBuffer)
-		builder.WriteString("Default value: <generated-at-run-time>\n")
+	return field, nil
+}
+
+func (c *AppConfig) getFieldValue(fieldSetKey, fieldKey, expectedType string) (any, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	return c.lookupFieldValue(fieldSetKey, fieldKey, expectedType)
+}
+
+// lookupFieldValue returns a field value, and expects the caller to hold the field-set lock.
+func (c *AppConfig) lookupFieldValue(fieldSetKey, fieldKey, expectedType string) (any, error) {
+	field, err := c.getField(fieldSetKey, fieldKey)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, loader := range c.loaders {
-		helpString := loader.HelpString(entry.fieldSetKey, entry.field.Key)
-		if helpString != "" {
-			builder.WriteString(spaceBuffer)
-			builder.WriteString(fmt.Sprintf("%s\n", helpString))
-		}
+	if expectedType != "" && expectedType != "any" && field.Type != expectedType {
+		return nil, fmt.Errorf("incorrect field-type for field '%s', found '%s'", fieldKey, field.Type)
 	}
 
-	for _, condition := range loadConditions {
-		fieldSetDependency, fieldDependency := condition.FieldDependency()
-		if fieldSetDependency != "" && fieldDependency != "" {
-			builder.WriteString(spaceBuffer)
-			builder.WriteString(
-				fmt.Sprintf("Loading depends on field: '%s_%s'\n", fieldSetDependency, fieldDependency),
-			)
-		} else {
-			builder.WriteString(spaceBuffer)
-			builder.WriteString("Loading depends on: <custom-load-condition-function>\n")
-		}
+	fieldValue, err := field.getValue()
+	if err != nil {
+		return nil, fmt.Errorf("no value set for field '%s'", fieldKey)
 	}
 
-	return builder.String()
+	return fieldValue, nil
 }
 
-func (c *AppConfig) printHelpString() {
-	fmt.Printf("%s", c.HelpString())
+type fieldEntry struct {
+	fieldSetKey    string
+	field          *Field
+	loadConditions LoadConditions
+	profiles       []string
 }
 
-// func (c *AppConfig) fieldSetLoadOrder() ([]*FieldSet, error) {
-// 	fieldSets := make([]*FieldSet, len(c.fieldSets))
-// 	fieldSetAvailable := map[string]struct{}{}
+// fields returns the field entries of every field-set, or of a single field-set when fieldSetKey is not empty.
+func (c *AppConfig) fields(fieldSetKey string) map[string]*fieldEntry {
+	fields := map[string]*fieldEntry{}
 
-// 	var iter func(fieldSet *FieldSet, seen map[string]struct{}) error
-// 	iter = func(fieldSet *FieldSet, seen map[string]struct{}) error {
-// 		if _, seen := seen[fieldSet.Key]; seen {
-// 			return fmt.Errorf("field-set cycle detected")
-// 		}
+	for key, fieldSet := range c.fieldSets {
+		if fieldSetKey != "" && key != fieldSetKey {
+			continue
+		}
 
-// 		if _, available := fieldSetAvailable[fieldSet.Key]; available {
-// 			return nil
-// 		}
+		for _, field := range fieldSet.fieldMap {
+			entry := fieldEntry{field: field, fieldSetKey: key, profiles: fieldSet.Profiles}
 
-// 		if len(fieldSet.LoadConditions) == 0 {
-// 			fieldSets = append(fieldSets, fieldSet)
-// 			fieldSetAvailable[fieldSet.Key] = struct{}{}
+			if len(fieldSet.LoadConditions) > 0 {
+				entry.loadConditions = fieldSet.LoadConditions
+			}
 
-// 			return nil
-// 		}
+			if len(field.LoadConditions) > 0 {
+				entry.loadConditions = append(entry.loadConditions, field.LoadConditions...)
+			}
 
-// 		for _, condition := range fieldSet.LoadConditions {
-// 			fieldSetKey, _ := condition.FieldDependency()
-// 			if fieldSetKey != "" {
-// 				if _, fieldSetExists := c.fieldSets[fieldSetKey]; !fieldSetExists {
-// 					return fmt.Errorf("field-set dependency on non-existent field-set: '%s'", fieldSetKey)
-// 				}
+			fields[fmt.Sprintf("%s_%s", key, field.Key)] = &entry
+		}
+	}
 
-// 				_, fieldSetAvailable := fieldSetAvailable[fieldSetKey]
-// 				if !fieldSetAvailable {
-// 					seen[fieldSet.Key] = struct{}{}
-// 					if err := iter(c.fieldSets[fieldSetKey], seen); err != nil {
-// 						return err
-// 					}
+	return fields
+}
+
+type helpSectionKeys struct {
+	title string
+	keys  []string
+}
 
-// 					continue
-// 				}
-// 			}
-// 		}
+// helpSections groups the sorted field keys into the required, conditionally required, and optional help sections,
+// omitting empty sections.
+func helpSections(fields map[string]*fieldEntry) []helpSectionKeys {
+	keys := make([]string, 0, len(fields))
 
-// 		fieldSets = append(fieldSets, fieldSet)
-// 		fieldSetAvailable[fieldSet.Key] = struct{}{}
+	for key := range fields {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
 
-// 		return nil
-// 	}
+	conditionallyRequiredFields := []string{}
+	requiredFields := []string{}
+	optionalFields := []string{}
 
-// 	for _, fieldSet := range c.fieldSets {
-// 		if err := iter(fieldSet, map[string]struct{}{}); err != nil {
-// 			return fieldSets, err
-// 		}
-// 	}
+	for _, key := range keys {
+		fieldEntry := fields[key]
 
-// 	return fieldSets, nil
-// }
+		switch {
+		case fieldEntry.field.Required && fieldEntry.loadConditions == nil && len(fieldEntry.profiles) < 1:
+			requiredFields = append(requiredFields, key)
+		case fieldEntry.field.Required && fieldEntry.loadConditions != nil,
+			len(fieldEntry.field.RequiredInProfiles) > 0,
+			fieldEntry.field.Required && len(fieldEntry.profiles) > 0:
+			conditionallyRequiredFields = append(conditionallyRequiredFields, key)
+		default:
+			optionalFields = append(optionalFields, key)
+		}
+	}
+
+	sections := []helpSectionKeys{}
+
+	for _, section := range []helpSectionKeys{
+		{title: "Required Configuration", keys: requiredFields},
+		{title: "Conditionally Required Configuration", keys: conditionallyRequiredFields},
+		{title: "Optional Configuration", keys: optionalFields},
+	} {
+		if len(section.keys) > 0 {
+			sections = append(sections, section)
+		}
+	}
+
+	return sections
+}
@@ -1,10 +1,12 @@
 package bconf_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -172,8 +174,8 @@ func TestAppConfig(t *testing.T) {
 		},
 	}
 
-	if errs := appConfig.AddFieldSet(conditionalFieldSet); len(errs) < 1 {
-		t.Fatalf("errors expected when adding a field set with an unmet load condition dependency")
+	if errs := appConfig.AddFieldSet(conditionalFieldSet); len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field set before its load condition dependency: %v", errs)
 	}
 
 	if errs := appConfig.AddFieldSet(appFieldSet); len(errs) > 0 {
@@ -184,8 +186,8 @@ func TestAppConfig(t *testing.T) {
 		t.Fatalf("errors expected when adding field set with duplicate key: %s", appFieldSet.Key)
 	}
 
-	if errs := appConfig.AddFieldSet(conditionalFieldSet); len(errs) > 1 {
-		t.Fatalf("unexpected errors adding conditional field-set: %v", errs)
+	if errs := appConfig.AddFieldSet(conditionalFieldSet); len(errs) < 1 {
+		t.Fatalf("errors expected when adding field set with duplicate key: %s", conditionalFieldSet.Key)
 	}
 
 	if errs := appConfig.Register(false); len(errs) < 1 {
@@ -377,20 +379,25 @@ func TestAppConfigWithLoadConditions(t *testing.T) {
 		},
 	}
 
-	if errs := appConfig.AddFieldSet(fieldSetWithLoadCondition); len(errs) < 1 {
-		t.Fatalf("expected error adding field set with unmet field-set load condition")
+	if errs := appConfig.AddFieldSet(fieldSetWithLoadCondition); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) adding field set before its load condition dependency: %v", errs)
 	}
 
 	if errs := appConfig.AddFieldSet(defaultFieldSet); len(errs) > 0 {
 		t.Fatalf("unexpected error(s) adding default field-set: %v", errs)
 	}
 
-	if errs := appConfig.AddFieldSet(fieldSetWithUnmetLoadCondition); len(errs) < 1 {
-		t.Fatalf("expected error adding field set with unmet field load condition")
+	unmetAppConfig := createBaseAppConfig()
+
+	if errs := unmetAppConfig.AddFieldSets(defaultFieldSet, fieldSetWithUnmetLoadCondition); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) adding field set with unmet field load condition: %v", errs)
 	}
 
-	if errs := appConfig.AddFieldSet(fieldSetWithLoadCondition); len(errs) > 0 {
-		t.Fatalf("unexpected error(s) adding field set with valid load condition: %v", errs)
+	errs := unmetAppConfig.Register(false)
+	if len(errs) < 1 {
+		t.Fatalf("expected error registering field set with unmet field load condition")
+	} else if !strings.Contains(errs[len(errs)-1].Error(), "field-set dependency field not found: default_load_app_two") {
+		t.Errorf("unexpected error registering field set with unmet field load condition: '%s'", errs[len(errs)-1])
 	}
 
 	_ = os.Setenv("DEFAULT_LOAD_APP_ONE", "true")
@@ -510,10 +517,679 @@ func TestAppConfigWithFieldLoadConditions(t *testing.T) {
 		t.Errorf("unexpected error adding field set with missing internal field dependencies: '%s'", errs[0])
 	}
 
-	if errs := appConfig.AddFieldSet(fieldSetWithMissingExternalFieldDependencies); len(errs) < 1 {
-		t.Fatalf("expected error adding field set with missing external field dependencies")
+	if errs := appConfig.AddFieldSet(fieldSetWithMissingExternalFieldDependencies); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) adding field set with missing external field dependencies: %v", errs)
+	}
+
+	if errs := appConfig.Register(false); len(errs) != 1 {
+		t.Fatalf("expected one error registering field set with missing external field dependencies: %v", errs)
 	} else if !strings.Contains(errs[0].Error(), "field-set dependency not found") {
-		t.Errorf("unexpected error adding field set with missing external field dependencies: '%s'", errs[0])
+		t.Errorf("unexpected error registering field set with missing external field dependencies: '%s'", errs[0])
+	}
+}
+
+func TestAppConfigRegisterCollectsErrors(t *testing.T) {
+	const fieldSetOneKey = "register_one"
+
+	const fieldSetTwoKey = "register_two"
+
+	const fieldSetThreeKey = "register_three"
+
+	const fieldSetFourKey = "register_four"
+
+	newAppConfig := func() *bconf.AppConfig {
+		appConfig := createBaseAppConfig()
+
+		errs := appConfig.AddFieldSets(
+			bconf.FSB().Key(fieldSetOneKey).Fields(
+				bconf.FB().Key("required_a").Type(bconf.String).Required().Create(),
+				bconf.FB().Key("required_b").Type(bconf.String).Required().Create(),
+			).Create(),
+			bconf.FSB().Key(fieldSetTwoKey).Fields(
+				bconf.FB().Key("optional").Type(bconf.String).Default("value").Create(),
+			).LoadConditions(
+				bconf.FCB().FieldSetKey(fieldSetOneKey).FieldKey("required_a").Condition(
+					func(fieldValue any) (bool, error) {
+						return true, nil
+					},
+				).Create(),
+			).Create(),
+			bconf.FSB().Key(fieldSetThreeKey).Fields(
+				bconf.FB().Key("required").Type(bconf.String).Required().Create(),
+			).Create(),
+			bconf.FSB().Key(fieldSetFourKey).Fields(
+				bconf.FB().Key("optional").Type(bconf.String).Default("value").Create(),
+			).Create(),
+		)
+		if len(errs) > 0 {
+			t.Fatalf("unexpected error(s) adding field-sets: %v", errs)
+		}
+
+		return appConfig
+	}
+
+	errs := newAppConfig().Register(false)
+	if len(errs) != 4 {
+		t.Fatalf("unexpected errors length '%d', expected '4': %v", len(errs), errs)
+	}
+
+	expectedMessages := []string{
+		fmt.Sprintf("required field '%s_required_a' not set", fieldSetOneKey),
+		fmt.Sprintf("required field '%s_required_b' not set", fieldSetOneKey),
+		fmt.Sprintf("field-set '%s' not loaded: field-set dependency '%s' has errors", fieldSetTwoKey, fieldSetOneKey),
+		fmt.Sprintf("required field '%s_required' not set", fieldSetThreeKey),
+	}
+
+	for idx, expectedMessage := range expectedMessages {
+		if !strings.Contains(errs[idx].Error(), expectedMessage) {
+			t.Errorf("unexpected error message at index %d: '%s', expected '%s'", idx, errs[idx], expectedMessage)
+		}
+	}
+
+	failFastAppConfig := newAppConfig()
+	failFastAppConfig.SetFailFast(true)
+
+	errs = failFastAppConfig.Register(false)
+	if len(errs) != 2 {
+		t.Fatalf("unexpected fail-fast errors length '%d', expected '2': %v", len(errs), errs)
+	}
+
+	if !strings.Contains(errs[1].Error(), fmt.Sprintf("required field '%s_required_b' not set", fieldSetOneKey)) {
+		t.Errorf("unexpected fail-fast error message: '%s'", errs[1])
+	}
+}
+
+func TestAppConfigStrictMode(t *testing.T) {
+	t.Setenv("BCONF_STRICT_LOG_LEVL", "debug")
+	t.Setenv("BCONF_STRICT_LOG_FORMAT", "json")
+
+	newAppConfig := func(loaders ...bconf.Loader) *bconf.AppConfig {
+		appConfig := bconf.NewAppConfig("app", "description")
+
+		if errs := appConfig.SetLoaders(loaders...); len(errs) > 0 {
+			t.Fatalf("unexpected errors setting loaders: %v", errs)
+		}
+
+		errs := appConfig.AddFieldSets(
+			bconf.FSB().Key("app").Fields(
+				bconf.FB().Key("id").Type(bconf.String).Create(),
+			).Create(),
+			bconf.FSB().Key("log").Fields(
+				bconf.FB().Key("level").Type(bconf.String).Default("info").Create(),
+				bconf.FB().Key("format").Type(bconf.String).Default("json").Create(),
+			).Create(),
+		)
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors adding field-sets: %v", errs)
+		}
+
+		return appConfig
+	}
+
+	environmentLoader := &bconf.EnvironmentLoader{KeyPrefix: "bconf_strict"}
+	flagLoader := &bconf.FlagLoader{OverrideLookup: []string{"--log_level", "warn", "--log_levle=debug"}}
+
+	if errs := newAppConfig(environmentLoader, flagLoader).Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering without strict mode: %v", errs)
+	}
+
+	appConfig := newAppConfig(environmentLoader, flagLoader)
+	appConfig.SetStrict(true)
+
+	errs := appConfig.Register(false)
+	if len(errs) != 2 {
+		t.Fatalf("unexpected errors length '%d', expected '2': %v", len(errs), errs)
+	}
+
+	expectedMessages := []string{
+		"unknown key 'BCONF_STRICT_LOG_LEVL' found by loader 'bconf_environment' " +
+			"(did you mean 'BCONF_STRICT_LOG_LEVEL'?)",
+		"unknown key 'log_levle' found by loader 'bconf_flags' (did you mean 'log_level'?)",
+	}
+
+	for idx, expectedMessage := range expectedMessages {
+		if errs[idx].Error() != expectedMessage {
+			t.Errorf("unexpected error message: '%s', expected '%s'", errs[idx], expectedMessage)
+		}
+	}
+
+	jsonLoader := bconf.NewJSONFileLoaderWithAttributes(nil, "./fixtures/json_config_test_fixture_01.json")
+	jsonLoader.Strict = true
+
+	errs = newAppConfig(jsonLoader).Register(false)
+	if len(errs) != 6 {
+		t.Fatalf("unexpected errors length '%d', expected '6': %v", len(errs), errs)
+	}
+
+	if errs[0].Error() != "unknown key 'app.internal_ports' found by loader 'bconf_jsonfile'" {
+		t.Errorf("unexpected error message: '%s'", errs[0])
+	}
+
+	if errs[1].Error() != "unknown key 'app.port' found by loader 'bconf_jsonfile'" {
+		t.Errorf("unexpected error message: '%s'", errs[1])
+	}
+
+	if errs[4].Error() != "unknown key 'app_id' found by loader 'bconf_jsonfile' (did you mean 'app.id'?)" {
+		t.Errorf("unexpected error message: '%s'", errs[4])
+	}
+
+	if errs[5].Error() != "unknown key 'strange_key' found by loader 'bconf_jsonfile'" {
+		t.Errorf("unexpected error message: '%s'", errs[5])
+	}
+}
+
+func TestAppConfigWarningHandlerReadsConfig(t *testing.T) {
+	t.Setenv("WARN_LOG_COLOR", "true")
+
+	appConfig := createBaseAppConfig()
+
+	warnings := []string{}
+	appConfig.SetWarningHandler(func(warning string) {
+		// the handler reads the app-config, which would deadlock if called while the app-config is locked
+		level, _ := appConfig.GetString("warn_log", "level")
+		warnings = append(warnings, fmt.Sprintf("%s (level: %s)", warning, level))
+	})
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("warn_log").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Default("info").Create(),
+		bconf.FB().Key("color").Type(bconf.Bool).Deprecated().Create(),
+	).Create())
+
+	done := make(chan []error)
+
+	go func() {
+		errs := appConfig.Register(false)
+		errs = append(errs, appConfig.LoadFieldSet("warn_log")...)
+		done <- errs
+	}()
+
+	select {
+	case errs := <-done:
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors registering app-config: %v", errs)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected register to return when the warning handler reads the app-config")
+	}
+
+	expectedWarning := "loader 'bconf_environment' found value for deprecated field 'warn_log_color' (level: info)"
+	if len(warnings) != 2 || warnings[0] != expectedWarning || warnings[1] != expectedWarning {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
+
+func TestAppConfigFieldAliases(t *testing.T) {
+	t.Setenv("ALIAS_LOG_LVL", "debug")
+	t.Setenv("LEGACY_FORMAT", "console")
+	t.Setenv("ALIAS_LOG_COLOR", "true")
+
+	appConfig := createBaseAppConfig()
+	appConfig.SetStrict(true)
+
+	warnings := []string{}
+	appConfig.SetWarningHandler(func(warning string) {
+		warnings = append(warnings, warning)
+	})
+
+	errs := appConfig.AddFieldSets(
+		bconf.FSB().Key("legacy").Fields(
+			bconf.FB().Key("unused").Type(bconf.String).Create(),
+		).Create(),
+		bconf.FSB().Key("alias_log").Fields(
+			bconf.FB().Key("level").Type(bconf.String).Aliases("lvl").Create(),
+			bconf.FB().Key("format").Type(bconf.String).Aliases("missing", "legacy.format").Create(),
+			bconf.FB().Key("color").Type(bconf.Bool).Deprecated().DeprecationMessage("colors are always on").Create(),
+		).Create(),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	if val, _ := appConfig.GetString("alias_log", "level"); val != "debug" {
+		t.Errorf("unexpected value loaded from field alias: '%s'", val)
+	}
+
+	if val, _ := appConfig.GetString("alias_log", "format"); val != "console" {
+		t.Errorf("unexpected value loaded from field-set field alias: '%s'", val)
+	}
+
+	expectedWarnings := []string{
+		"loader 'bconf_environment' found deprecated alias 'ALIAS_LOG_LVL' for field 'alias_log_level', " +
+			"use 'ALIAS_LOG_LEVEL' instead",
+		"loader 'bconf_environment' found deprecated alias 'LEGACY_FORMAT' for field 'alias_log_format', " +
+			"use 'ALIAS_LOG_FORMAT' instead",
+		"loader 'bconf_environment' found value for deprecated field 'alias_log_color': colors are always on",
+	}
+
+	if len(warnings) != len(expectedWarnings) {
+		t.Fatalf("unexpected warnings length '%d', expected '%d': %v", len(warnings), len(expectedWarnings), warnings)
+	}
+
+	for idx, expectedWarning := range expectedWarnings {
+		if warnings[idx] != expectedWarning {
+			t.Errorf("unexpected warning: '%s', expected '%s'", warnings[idx], expectedWarning)
+		}
+	}
+
+	helpString := appConfig.HelpString()
+	if !strings.Contains(helpString, "Environment key: 'ALIAS_LOG_LVL' (deprecated alias)") {
+		t.Errorf("expected help string to contain deprecated alias environment key: %s", helpString)
+	}
+
+	if !strings.Contains(helpString, "Deprecated: colors are always on") {
+		t.Errorf("expected help string to contain deprecation message: %s", helpString)
+	}
+
+	errs = appConfig.AddFieldSet(bconf.FSB().Key("conflicting_aliases").Fields(
+		bconf.FB().Key("a").Type(bconf.String).Aliases("b").Create(),
+		bconf.FB().Key("b").Type(bconf.String).Create(),
+	).Create())
+	if len(errs) != 1 {
+		t.Fatalf("unexpected errors length '%d' adding field-set with conflicting aliases: %v", len(errs), errs)
+	} else if !strings.Contains(errs[0].Error(), "field 'a' alias conflicts with field key: 'b'") {
+		t.Errorf("unexpected error message: %s", errs[0])
+	}
+
+	errs = appConfig.AddFieldSet(bconf.FSB().Key("invalid_aliases").Fields(
+		bconf.FB().Key("a").Type(bconf.String).Aliases("a", "b.c.d", "e", "e").Create(),
+	).Create())
+	if len(errs) != 3 {
+		t.Fatalf("unexpected errors length '%d' adding field-set with invalid aliases, expected '3': %v", len(errs), errs)
+	}
+
+	errs = appConfig.AddFieldSet(bconf.FSB().Key("shadowing_alias").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Aliases("alias_log.level").Create(),
+	).Create())
+	if len(errs) != 1 || errs[0].Error() != "field 'shadowing_alias_level' alias conflicts with field: 'alias_log.level'" {
+		t.Errorf("unexpected errors adding field-set with alias shadowing another field-set field: %v", errs)
+	}
+
+	errs = appConfig.AddField("legacy", bconf.FB().Key("format").Type(bconf.String).Create())
+	if len(errs) != 1 ||
+		errs[0].Error() != "field 'legacy_format' conflicts with alias of field 'alias_log_format': 'legacy.format'" {
+		t.Errorf("unexpected errors adding field shadowed by another field-set alias: %v", errs)
+	}
+}
+
+// countingTestLoader counts the lookups made against its values, which are keyed by '<field-set>_<field>'.
+type countingTestLoader struct {
+	values   map[string]string
+	lookups  int
+	getCalls int
+}
+
+func (l *countingTestLoader) CloneLoader() bconf.Loader {
+	return l
+}
+
+func (l *countingTestLoader) Name() string {
+	return "counting_test"
+}
+
+func (l *countingTestLoader) Get(fieldSetKey, fieldKey string) (string, bool) {
+	l.getCalls++
+	value, found := l.values[fieldSetKey+"_"+fieldKey]
+
+	return value, found
+}
+
+func (l *countingTestLoader) GetMap(fieldSetKey string, fieldKeys []string) map[string]string {
+	l.lookups++
+	values := map[string]string{}
+
+	for _, fieldKey := range fieldKeys {
+		if value, found := l.values[fieldSetKey+"_"+fieldKey]; found {
+			values[fieldKey] = value
+		}
+	}
+
+	return values
+}
+
+func (l *countingTestLoader) HelpString(fieldSetKey, fieldKey string) string {
+	return ""
+}
+
+func TestAppConfigFieldAliasLookups(t *testing.T) {
+	loader := &countingTestLoader{values: map[string]string{
+		"alias_reads_lvl":    "debug",
+		"alias_reads_fmt":    "json",
+		"old_reads_colorful": "true",
+	}}
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	appConfig.SetLoaders(loader)
+
+	errs := appConfig.AddFieldSet(bconf.FSB().Key("alias_reads").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Aliases("old_level", "lvl").Create(),
+		bconf.FB().Key("format").Type(bconf.String).Aliases("fmt").Create(),
+		bconf.FB().Key("color").Type(bconf.Bool).Aliases("old_reads.colorful").Create(),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-set: %v", errs)
+	}
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	if val, _ := appConfig.GetString("alias_reads", "level"); val != "debug" {
+		t.Errorf("unexpected value loaded from field alias: '%s'", val)
+	}
+
+	if val, _ := appConfig.GetBool("alias_reads", "color"); !val {
+		t.Errorf("unexpected value loaded from field-set field alias: '%v'", val)
+	}
+
+	// one lookup for the field-set, and one for each alias field-set
+	if loader.getCalls != 0 || loader.lookups != 3 {
+		t.Errorf("unexpected loader lookups: '%d' Get calls, '%d' lookups", loader.getCalls, loader.lookups)
+	}
+}
+
+func TestAppConfigWithBuiltInLoadConditions(t *testing.T) {
+	t.Setenv("CONDITIONS_LOG_FORMAT", "console")
+	t.Setenv("CONDITIONS_LOG_COLOR_ENABLED", "true")
+	t.Setenv("CONDITIONS_LOG_COLOR_THEME", "dark")
+	t.Setenv("CONDITIONS_LOG_JSON_INDENT", "2")
+
+	appConfig := createBaseAppConfig()
+
+	errs := appConfig.AddFieldSets(
+		bconf.FSB().Key("conditions_log").Fields(
+			bconf.FB().Key("format").Type(bconf.String).Default("json").Create(),
+			bconf.FB().Key("color_enabled").Type(bconf.Bool).Default(false).Create(),
+			bconf.FB().Key("color_theme").Type(bconf.String).LoadConditions(
+				bconf.And(bconf.Equals("", "format", "console"), bconf.IsTrue("", "color_enabled")),
+			).Create(),
+			bconf.FB().Key("json_indent").Type(bconf.Int).LoadConditions(
+				bconf.Not(bconf.Equals("conditions_log", "format", "console")),
+			).Create(),
+		).Create(),
+		bconf.FSB().Key("conditions_file").Fields(
+			bconf.FB().Key("path").Type(bconf.String).Required().Create(),
+		).LoadConditions(
+			bconf.Or(bconf.IsSet("conditions_log", "json_indent"), bconf.Matches("conditions_log", "format", "^file")),
+		).Create(),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	if val, _ := appConfig.GetString("conditions_log", "color_theme"); val != "dark" {
+		t.Errorf("unexpected color_theme value: '%s'", val)
+	}
+
+	if _, err := appConfig.GetInt("conditions_log", "json_indent"); err == nil {
+		t.Errorf("expected error getting json_indent value with unmet load condition")
+	}
+
+	helpString := appConfig.HelpString()
+	expectedHelpLines := []string{
+		"Loaded when format == 'console' and color_enabled is true",
+		"Loaded when not conditions_log.format == 'console'",
+		"Loaded when conditions_log.json_indent is set or conditions_log.format matches '^file'",
+	}
+
+	for _, expectedHelpLine := range expectedHelpLines {
+		if !strings.Contains(helpString, expectedHelpLine) {
+			t.Errorf("expected help string to contain '%s': %s", expectedHelpLine, helpString)
+		}
+	}
+
+	errs = appConfig.AddFieldSet(bconf.FSB().Key("conditions_missing").LoadConditions(
+		bconf.And(bconf.IsSet("conditions_log", "format"), bconf.IsSet("conditions_log", "missing")),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-set with missing dependency: %v", errs)
+	}
+
+	errs = appConfig.Register(false)
+	if len(errs) != 1 {
+		t.Fatalf("unexpected errors length '%d' registering field-set with missing dependency: %v", len(errs), errs)
+	} else if !strings.Contains(errs[0].Error(), "field-set dependency field not found: conditions_log_missing") {
+		t.Errorf("unexpected error message: %s", errs[0])
+	}
+}
+
+func TestAppConfigFieldSetLoadOrder(t *testing.T) {
+	t.Setenv("ORDER_BASE_MODE", "extended")
+
+	appConfig := createBaseAppConfig()
+
+	errs := appConfig.AddFieldSets(
+		bconf.FSB().Key("order_extended").Fields(
+			bconf.FB().Key("enabled").Type(bconf.Bool).Default(true).Create(),
+		).LoadConditions(bconf.Equals("order_base", "mode", "extended")).Create(),
+		bconf.FSB().Key("order_extended_detail").Fields(
+			bconf.FB().Key("level").Type(bconf.Int).Default(1).LoadConditions(
+				bconf.IsTrue("order_extended", "enabled"),
+			).Create(),
+		).Create(),
+		bconf.FSB().Key("order_base").Fields(
+			bconf.FB().Key("mode").Type(bconf.String).Default("basic").Create(),
+		).Create(),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets out of dependency order: %v", errs)
+	}
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering field-sets out of dependency order: %v", errs)
+	}
+
+	if val, err := appConfig.GetBool("order_extended", "enabled"); err != nil || !val {
+		t.Errorf("unexpected order_extended enabled value '%v': %v", val, err)
+	}
+
+	if val, err := appConfig.GetInt("order_extended_detail", "level"); err != nil || val != 1 {
+		t.Errorf("unexpected order_extended_detail level value '%d': %v", val, err)
+	}
+}
+
+func TestAppConfigFieldSetDependencyCycles(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	errs := appConfig.AddFieldSets(
+		bconf.FSB().Key("cycle_a").Fields(
+			bconf.FB().Key("value").Type(bconf.String).Default("a").Create(),
+		).LoadConditions(bconf.IsSet("cycle_c", "value")).Create(),
+		bconf.FSB().Key("cycle_b").Fields(
+			bconf.FB().Key("value").Type(bconf.String).Default("b").Create(),
+		).LoadConditions(bconf.IsSet("cycle_a", "value")).Create(),
+		bconf.FSB().Key("cycle_c").Fields(
+			bconf.FB().Key("value").Type(bconf.String).Default("c").LoadConditions(
+				bconf.IsSet("cycle_b", "value"),
+			).Create(),
+		).Create(),
+		bconf.FSB().Key("cycle_dependent").Fields(
+			bconf.FB().Key("value").Type(bconf.String).Default("d").Create(),
+		).LoadConditions(bconf.IsSet("cycle_b", "value")).Create(),
+		bconf.FSB().Key("cycle_self").Fields(
+			bconf.FB().Key("value").Type(bconf.String).Default("self").Create(),
+		).LoadConditions(bconf.IsSet("cycle_self", "value")).Create(),
+		bconf.FSB().Key("cycle_free").Fields(
+			bconf.FB().Key("value").Type(bconf.String).Default("free").Create(),
+		).Create(),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	errs = appConfig.Register(false)
+
+	expectedMessages := []string{
+		"field-set dependency cycle detected: cycle_a -> cycle_c -> cycle_b -> cycle_a",
+		"field-set 'cycle_dependent' not loaded: field-set dependency 'cycle_b' has errors",
+		"field-set dependency cycle detected: cycle_self -> cycle_self",
+	}
+
+	if len(errs) != len(expectedMessages) {
+		t.Fatalf("unexpected errors length '%d', expected '%d': %v", len(errs), len(expectedMessages), errs)
+	}
+
+	for idx, expectedMessage := range expectedMessages {
+		if errs[idx].Error() != expectedMessage {
+			t.Errorf("unexpected error message: '%s', expected '%s'", errs[idx], expectedMessage)
+		}
+	}
+
+	if val, err := appConfig.GetString("cycle_free", "value"); err != nil || val != "free" {
+		t.Errorf("unexpected cycle_free value '%s': %v", val, err)
+	}
+
+	errs = appConfig.AddField("cycle_free", bconf.FB().Key("dependent").Type(bconf.String).LoadConditions(
+		bconf.IsSet("cycle_dependent", "value"),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field with field-set dependency: %v", errs)
+	}
+
+	errs = appConfig.AddField("cycle_dependent", bconf.FB().Key("free").Type(bconf.String).LoadConditions(
+		bconf.IsSet("cycle_free", "value"),
+	).Create())
+	if len(errs) != 1 {
+		t.Fatalf("unexpected errors length '%d' adding field creating a dependency cycle: %v", len(errs), errs)
+	} else if !strings.Contains(errs[0].Error(), "field-set dependency cycle detected") {
+		t.Errorf("unexpected error message: %s", errs[0])
+	}
+
+	if keys, _ := appConfig.GetFieldSetFieldKeys("cycle_dependent"); len(keys) != 1 {
+		t.Errorf("unexpected field keys after rejected field: %v", keys)
+	}
+
+	errs = appConfig.AddFieldSet(bconf.FSB().Key("cycle_fields").Fields(
+		bconf.FB().Key("a").Type(bconf.String).LoadConditions(bconf.IsSet("", "b")).Create(),
+		bconf.FB().Key("b").Type(bconf.String).LoadConditions(bconf.IsSet("cycle_fields", "c")).Create(),
+		bconf.FB().Key("c").Type(bconf.String).LoadConditions(bconf.IsSet("", "a")).Create(),
+		bconf.FB().Key("self").Type(bconf.String).LoadConditions(bconf.IsSet("", "self")).Create(),
+		bconf.FB().Key("free").Type(bconf.String).LoadConditions(bconf.IsSet("", "a")).Create(),
+	).Create())
+
+	expectedMessages = []string{
+		"field-set 'cycle_fields' field dependency cycle detected: a -> b -> c -> a",
+		"field-set 'cycle_fields' field dependency cycle detected: self -> self",
+	}
+
+	if len(errs) != len(expectedMessages) {
+		t.Fatalf("unexpected errors length '%d' adding field-set with field dependency cycles: %v", len(errs), errs)
+	}
+
+	for idx, expectedMessage := range expectedMessages {
+		if errs[idx].Error() != expectedMessage {
+			t.Errorf("unexpected error message: '%s', expected '%s'", errs[idx], expectedMessage)
+		}
+	}
+}
+
+func TestAppConfigFieldLoadConditionsAcrossLoaders(t *testing.T) {
+	type loaderDependencyTest struct {
+		environment   map[string]string
+		flags         []string
+		expectedTheme string
+		expectedFound bool
+	}
+
+	testCases := map[string]loaderDependencyTest{
+		"dependency-set-by-later-loader": {
+			environment:   map[string]string{"MERGED_LOG_THEME": "dark"},
+			flags:         []string{"--merged_log_format=console"},
+			expectedTheme: "dark",
+			expectedFound: true,
+		},
+		"dependency-overridden-by-later-loader": {
+			environment:   map[string]string{"MERGED_LOG_FORMAT": "console", "MERGED_LOG_THEME": "dark"},
+			flags:         []string{"--merged_log_format=json"},
+			expectedFound: false,
+		},
+		"dependent-set-by-later-loader": {
+			environment:   map[string]string{"MERGED_LOG_FORMAT": "console"},
+			flags:         []string{"--merged_log_theme=light"},
+			expectedTheme: "light",
+			expectedFound: true,
+		},
+		"dependent-set-by-both-loaders": {
+			environment:   map[string]string{"MERGED_LOG_THEME": "dark"},
+			flags:         []string{"--merged_log_theme=light", "--merged_log_format=console"},
+			expectedTheme: "light",
+			expectedFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		for key, value := range testCase.environment {
+			t.Setenv(key, value)
+		}
+
+		appConfig := bconf.NewAppConfig("app", "description")
+		_ = appConfig.SetLoaders(&bconf.EnvironmentLoader{}, &bconf.FlagLoader{OverrideLookup: testCase.flags})
+
+		// the dependent field is declared before its dependency to ensure fields are loaded in dependency order
+		errs := appConfig.AddFieldSet(bconf.FSB().Key("merged_log").Fields(
+			bconf.FB().Key("theme").Type(bconf.String).LoadConditions(
+				bconf.Equals("", "format", "console"),
+			).Create(),
+			bconf.FB().Key("format").Type(bconf.String).Default("json").Create(),
+		).Create())
+		if len(errs) > 0 {
+			t.Fatalf("%s: unexpected errors adding field-set: %v", name, errs)
+		}
+
+		if errs := appConfig.Register(false); len(errs) > 0 {
+			t.Fatalf("%s: unexpected errors registering app-config: %v", name, errs)
+		}
+
+		theme, err := appConfig.GetString("merged_log", "theme")
+		if found := err == nil; found != testCase.expectedFound {
+			t.Errorf("%s: unexpected theme found '%v' (%v), expected '%v'", name, found, err, testCase.expectedFound)
+		}
+
+		if theme != testCase.expectedTheme {
+			t.Errorf("%s: unexpected theme value '%s', expected '%s'", name, theme, testCase.expectedTheme)
+		}
+
+		for key := range testCase.environment {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+func TestAppConfigReloadClearsRemovedValues(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	errs := appConfig.AddFieldSet(bconf.FSB().Key("reload_clear").Fields(
+		bconf.FB().Key("value").Type(bconf.String).Default("default").Create(),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-set: %v", errs)
+	}
+
+	t.Setenv("RELOAD_CLEAR_VALUE", "environment")
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	if val, _ := appConfig.GetString("reload_clear", "value"); val != "environment" {
+		t.Fatalf("unexpected value after register: '%s'", val)
+	}
+
+	os.Unsetenv("RELOAD_CLEAR_VALUE")
+
+	if errs := appConfig.LoadFieldSet("reload_clear"); len(errs) > 0 {
+		t.Fatalf("unexpected errors reloading field-set: %v", errs)
+	}
+
+	if val, _ := appConfig.GetString("reload_clear", "value"); val != "default" {
+		t.Errorf("unexpected value after reload with removed environment value: '%s'", val)
 	}
 }
 
@@ -1693,6 +2369,216 @@ func TestAppConfigFillStruct(t *testing.T) {
 	}
 }
 
+func TestAppConfigProfiles(t *testing.T) {
+	newProfileAppConfig := func() *bconf.AppConfig {
+		appConfig := createBaseAppConfig()
+		appConfig.SetProfileField("app", "profile")
+
+		errs := appConfig.AddFieldSets(
+			bconf.FSB().Key("log").Fields(
+				bconf.FB().Key("level").Type(bconf.String).Default("info").ProfileDefault("dev", "debug").Create(),
+				bconf.FB().Key("token").Type(bconf.String).RequiredInProfiles("prod").Sensitive().Create(),
+			).Create(),
+			bconf.FSB().Key("debug").Profiles("dev").Fields(
+				bconf.FB().Key("pprof").Type(bconf.Bool).Default(true).Create(),
+			).Create(),
+			bconf.FSB().Key("app").Fields(
+				bconf.FB().Key("profile").Type(bconf.String).Enumeration("dev", "prod").Create(),
+			).Create(),
+		)
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors adding field-sets: %v", errs)
+		}
+
+		return appConfig
+	}
+
+	t.Setenv("DEBUG_PPROF", "false")
+	t.Setenv("APP_PROFILE", "dev")
+
+	appConfig := newProfileAppConfig()
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	if appConfig.Profile() != "dev" {
+		t.Errorf("unexpected profile: '%s'", appConfig.Profile())
+	}
+
+	if val, _ := appConfig.GetString("log", "level"); val != "debug" {
+		t.Errorf("unexpected dev profile log level: '%s'", val)
+	}
+
+	if val, _ := appConfig.GetBool("debug", "pprof"); val {
+		t.Errorf("expected dev profile field-set to be loaded")
+	}
+
+	helpString := appConfig.HelpString()
+	for _, expected := range []string{
+		"Default value (dev): 'debug'",
+		"Required in profiles: prod",
+		"Loaded in profiles: dev",
+		"Selects the configuration profile",
+	} {
+		if !strings.Contains(helpString, expected) {
+			t.Errorf("expected help string to contain '%s': %s", expected, helpString)
+		}
+	}
+
+	t.Setenv("APP_PROFILE", "prod")
+
+	appConfig = newProfileAppConfig()
+
+	errs := appConfig.Register(false)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "required field 'log_token' not set in profile 'prod'") {
+		t.Fatalf("unexpected errors registering app-config in prod profile: %v", errs)
+	}
+
+	t.Setenv("LOG_TOKEN", "token")
+
+	appConfig = newProfileAppConfig()
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config in prod profile: %v", errs)
+	}
+
+	if val, _ := appConfig.GetString("log", "level"); val != "info" {
+		t.Errorf("unexpected prod profile log level: '%s'", val)
+	}
+
+	if val, _ := appConfig.GetBool("debug", "pprof"); !val {
+		t.Errorf("unexpected dev profile field-set loaded in prod profile")
+	}
+}
+
+func TestAppConfigProfileValidation(t *testing.T) {
+	appConfig := createBaseAppConfig()
+	appConfig.SetProfileField("app", "profile")
+
+	if errs := appConfig.Register(false); len(errs) != 1 {
+		t.Errorf("unexpected errors registering with missing profile field: %v", errs)
+	}
+
+	appConfig = createBaseAppConfig()
+	appConfig.SetProfileField("app", "profile")
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("app").Fields(
+		bconf.FB().Key("profile").Type(bconf.Int).Create(),
+	).Create())
+
+	if errs := appConfig.Register(false); len(errs) != 1 {
+		t.Errorf("unexpected errors registering with non-string profile field: %v", errs)
+	}
+
+	errs := appConfig.AddFieldSet(bconf.FSB().Key("invalid").Fields(
+		bconf.FB().Key("required").Type(bconf.String).Required().ProfileDefault("dev", "value").Create(),
+		bconf.FB().Key("mismatch").Type(bconf.String).ProfileDefault("dev", 1).Create(),
+		bconf.FB().Key("conflict").Type(bconf.String).RequiredInProfiles("dev").ProfileDefault("dev", "a").Create(),
+		bconf.FB().Key("enum").Type(bconf.String).Enumeration("a").ProfileDefault("dev", "b").Create(),
+	).Create())
+	if len(errs) != 4 {
+		t.Errorf("unexpected errors adding field-set with invalid profile settings: %v", errs)
+	}
+}
+
+func TestAppConfigConcurrentAccess(t *testing.T) {
+	type ConcurrencyConfig struct {
+		bconf.ConfigStruct `bconf:"concurrency"`
+		Level              string `bconf:"level"`
+		Limit              int    `bconf:"limit"`
+	}
+
+	const iterations = 200
+
+	appConfig := createBaseAppConfig()
+
+	errs := appConfig.AddFieldSet(bconf.FSB().Key("concurrency").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Default("info").Create(),
+		bconf.FB().Key("limit").Type(bconf.Int).Default(10).Create(),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-set: %v", errs)
+	}
+
+	loader := &notifyingTestLoader{values: map[string]string{}, changes: make(chan struct{})}
+	_ = appConfig.SetLoaders(&bconf.EnvironmentLoader{}, loader)
+	appConfig.SetWatchOptions(bconf.WatchOptions{Debounce: time.Nanosecond})
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := appConfig.Watch(ctx); err != nil {
+		t.Fatalf("unexpected error watching app-config: %s", err)
+	}
+
+	// handlers may call back into the app-config, as they are called after the field-set lock is released
+	_ = appConfig.OnChange("concurrency", "limit", func(oldValue, newValue any) {
+		_, _ = appConfig.GetString("concurrency", "level")
+	})
+
+	wg := sync.WaitGroup{}
+	run := func(operation func(iteration int)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for iteration := 0; iteration < iterations; iteration++ {
+				operation(iteration)
+			}
+		}()
+	}
+
+	run(func(iteration int) {
+		if err := appConfig.SetField("concurrency", "limit", iteration); err != nil {
+			t.Errorf("unexpected error setting field: %s", err)
+		}
+	})
+	run(func(int) {
+		if errs := appConfig.LoadFieldSet("concurrency"); len(errs) > 0 {
+			t.Errorf("unexpected errors loading field-set: %v", errs)
+		}
+	})
+	run(func(int) {
+		if errs := appConfig.LoadField("concurrency", "level"); len(errs) > 0 {
+			t.Errorf("unexpected errors loading field: %v", errs)
+		}
+	})
+	run(func(int) {
+		if _, err := appConfig.GetInt("concurrency", "limit"); err != nil {
+			t.Errorf("unexpected error getting field value: %s", err)
+		}
+	})
+	run(func(int) {
+		configStruct := &ConcurrencyConfig{}
+		if err := appConfig.FillStruct(configStruct); err != nil {
+			t.Errorf("unexpected error filling struct: %s", err)
+		}
+	})
+	run(func(int) {
+		_ = appConfig.ConfigMap()
+		_ = appConfig.HelpString()
+		_ = appConfig.GetFieldSetKeys()
+	})
+	run(func(int) {
+		// watch reloads must not replace field values set while the reload was staged
+		select {
+		case loader.changes <- struct{}{}:
+		default:
+		}
+	})
+
+	wg.Wait()
+	time.Sleep(50 * time.Millisecond)
+
+	if val, _ := appConfig.GetInt("concurrency", "limit"); val != iterations-1 {
+		t.Errorf("unexpected final limit value '%d', expected '%d'", val, iterations-1)
+	}
+}
+
 func createBaseAppConfig() *bconf.AppConfig {
 	appConfig := bconf.NewAppConfig(
 		"app",
@@ -3,4 +3,8 @@ package bconfconst
 const (
 	ErrorFieldDefaultSetting      = "invalid settings: cannot set both Default and DefaultGenerator"
 	ErrorFieldRequiredWithDefault = "invalid settings: cannot set both Required and Default/DefaultGenerator"
+
+	ErrorFieldRequiredWithProfileDefault   = "invalid settings: cannot set both Required and ProfileDefaults"
+	ErrorFieldRequiredInProfileWithDefault = "invalid settings: cannot set both RequiredInProfiles and ProfileDefaults " +
+		"for the same profile"
 )
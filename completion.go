@@ -0,0 +1,268 @@
+package bconf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	CompletionShellBash = "bash"
+	CompletionShellZsh  = "zsh"
+	CompletionShellFish = "fish"
+)
+
+type completionFlag struct {
+	name        string
+	description string
+	values      []string
+	// takesValue is false for flags that are set without a value, e.g. bool fields and the help flag
+	takesValue bool
+	// optionalValue identifies flags that can be set with or without a value, e.g. the print-config flag
+	optionalValue bool
+}
+
+// GenerateCompletion generates a bash, zsh, or fish completion script for the program named by the app name, completing
+// the FlagLoader flag of every field, the help flag, and the print-config flag when it is handled. Enumeration values
+// are completed as flag values, and field descriptions are shown where the shell supports them. The app-config must
+// have a FlagLoader.
+func (c *AppConfig) GenerateCompletion(shell string) ([]byte, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	var loader *FlagLoader
+
+	for _, appConfigLoader := range c.loaders {
+		if flagLoader, ok := appConfigLoader.(*FlagLoader); ok {
+			loader = flagLoader
+		}
+	}
+
+	if loader == nil {
+		return nil, fmt.Errorf("completion scripts require a FlagLoader")
+	}
+
+	command := c.appName
+	if command == "" {
+		command = filepath.Base(os.Args[0])
+	}
+
+	flags := c.completionFlags(loader)
+
+	switch shell {
+	case CompletionShellBash:
+		return bashCompletion(command, flags), nil
+	case CompletionShellZsh:
+		return zshCompletion(command, flags), nil
+	case CompletionShellFish:
+		return fishCompletion(command, flags), nil
+	default:
+		return nil, fmt.Errorf("unsupported completion shell: '%s'", shell)
+	}
+}
+
+// -- Private methods --
+
+func (c *AppConfig) completionFlags(loader *FlagLoader) []completionFlag {
+	flags := []completionFlag{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		for _, field := range fieldSet.orderedFields() {
+			flag := completionFlag{
+				name:        loader.SourceKey(fieldSet.Key, field.Key),
+				description: field.Description,
+				takesValue:  field.Type != Bool,
+			}
+
+			if flag.description == "" {
+				flag.description = field.Type
+			}
+
+			for _, value := range field.Enumeration {
+				flag.values = append(flag.values, fmt.Sprintf("%v", value))
+			}
+
+			flags = append(flags, flag)
+		}
+	}
+
+	flags = append(flags, completionFlag{name: "help", description: "Show help"})
+
+	if c.handlePrintConfigFlag {
+		flags = append(flags, completionFlag{
+			name:          printConfigFlag,
+			description:   "Print the effective configuration",
+			values:        []string{ReportFormatText, ReportFormatJSON},
+			optionalValue: true,
+		})
+	}
+
+	return flags
+}
+
+// completionFunctionName returns the command as a shell function name, with unsupported characters replaced.
+func completionFunctionName(command string) string {
+	return "_" + strings.Map(func(character rune) rune {
+		switch {
+		case character >= 'a' && character <= 'z', character >= 'A' && character <= 'Z':
+		case character >= '0' && character <= '9', character == '_':
+		default:
+			return '_'
+		}
+
+		return character
+	}, command)
+}
+
+func bashCompletion(command string, flags []completionFlag) []byte {
+	function := completionFunctionName(command)
+	flagNames := make([]string, len(flags))
+	builder := strings.Builder{}
+
+	builder.WriteString(fmt.Sprintf("# bash completion for %s\n\n", command))
+	builder.WriteString(fmt.Sprintf("%s() {\n", function))
+	builder.WriteString("\tlocal cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	builder.WriteString("\tlocal prev=\"\"\n\n")
+	builder.WriteString("\tif [[ ${COMP_CWORD} -gt 0 ]]; then\n\t\tprev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n\tfi\n\n")
+	builder.WriteString("\t# '=' is a completion word break, so '--flag=value' is split into '--flag', '=', and 'value'\n")
+	builder.WriteString("\tif [[ \"${cur}\" == \"=\" ]]; then\n\t\tcur=\"\"\n")
+	builder.WriteString("\telif [[ \"${prev}\" == \"=\" && ${COMP_CWORD} -gt 1 ]]; then\n")
+	builder.WriteString("\t\tprev=\"${COMP_WORDS[COMP_CWORD-2]}\"\n\tfi\n\n")
+	builder.WriteString("\tcase \"${prev}\" in\n")
+
+	for index, flag := range flags {
+		flagNames[index] = "--" + flag.name
+
+		if len(flag.values) < 1 {
+			continue
+		}
+
+		values := make([]string, len(flag.values))
+		for valueIndex, value := range flag.values {
+			values[valueIndex] = shellQuote(value)
+		}
+
+		// values are split on newlines rather than spaces, so that values containing spaces are completed as one word
+		builder.WriteString(fmt.Sprintf("\t--%s)\n\t\tlocal IFS=$'\\n'\n", flag.name))
+		builder.WriteString(fmt.Sprintf(
+			"\t\tCOMPREPLY=($(compgen -W \"$(printf '%%s\\n' %s)\" -- \"${cur}\"))\n",
+			strings.Join(values, " "),
+		))
+		builder.WriteString("\t\treturn 0\n\t\t;;\n")
+	}
+
+	builder.WriteString("\tesac\n\n")
+	builder.WriteString(fmt.Sprintf(
+		"\tCOMPREPLY=($(compgen -W %s -- \"${cur}\"))\n",
+		shellQuote(strings.Join(append(flagNames, "-h"), " ")),
+	))
+	builder.WriteString("}\n\n")
+	builder.WriteString(fmt.Sprintf("complete -F %s %s\n", function, command))
+
+	return []byte(builder.String())
+}
+
+func zshCompletion(command string, flags []completionFlag) []byte {
+	function := completionFunctionName(command)
+	specs := []string{}
+
+	for _, flag := range flags {
+		description := zshEscape(flag.description)
+
+		switch {
+		case flag.name == "help":
+			specs = append(specs, fmt.Sprintf("(-h --help)--help[%s]", description))
+			specs = append(specs, fmt.Sprintf("(-h --help)-h[%s]", description))
+		case len(flag.values) > 0:
+			values := make([]string, len(flag.values))
+			for index, value := range flag.values {
+				values[index] = strings.ReplaceAll(zshEscape(value), " ", `\ `)
+			}
+
+			specs = append(specs, zshValuesSpec(flag, description, values))
+		case flag.takesValue:
+			specs = append(specs, fmt.Sprintf("--%s=[%s]:value: ", flag.name, description))
+		default:
+			specs = append(specs, fmt.Sprintf("--%s[%s]", flag.name, description))
+		}
+	}
+
+	builder := strings.Builder{}
+
+	builder.WriteString(fmt.Sprintf("#compdef %s\n\n", command))
+	builder.WriteString(fmt.Sprintf("%s() {\n", function))
+	builder.WriteString("\t_arguments")
+
+	for _, spec := range specs {
+		builder.WriteString(fmt.Sprintf(" \\\n\t\t'%s'", spec))
+	}
+
+	builder.WriteString("\n}\n\n")
+	builder.WriteString(fmt.Sprintf("if [ \"$funcstack[1]\" = \"%s\" ]; then\n", function))
+	builder.WriteString(fmt.Sprintf("\t%s \"$@\"\nelse\n\tcompdef %s %s\nfi\n", function, function, command))
+
+	return []byte(builder.String())
+}
+
+// zshValuesSpec returns the _arguments specification of a flag with completed values, with the value marked as
+// optional for flags that can be set without one.
+func zshValuesSpec(flag completionFlag, description string, values []string) string {
+	if flag.optionalValue {
+		return fmt.Sprintf("--%s=-[%s]:format:(%s)", flag.name, description, strings.Join(values, " "))
+	}
+
+	return fmt.Sprintf("--%s=[%s]:value:(%s)", flag.name, description, strings.Join(values, " "))
+}
+
+// zshEscape escapes text for use in a single-quoted _arguments specification.
+func zshEscape(text string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		"'", `'\''`,
+		"[", `\[`,
+		"]", `\]`,
+		":", `\:`,
+		"(", `\(`,
+		")", `\)`,
+	)
+
+	return replacer.Replace(text)
+}
+
+func fishCompletion(command string, flags []completionFlag) []byte {
+	builder := strings.Builder{}
+
+	builder.WriteString(fmt.Sprintf("# fish completion for %s\n\n", command))
+
+	for _, flag := range flags {
+		line := fmt.Sprintf("complete -c %s -l %s", command, flag.name)
+
+		if flag.name == "help" {
+			line += " -s h"
+		}
+
+		line += fmt.Sprintf(" -d %s", fishQuote(flag.description))
+
+		switch {
+		case len(flag.values) > 0:
+			values := make([]string, len(flag.values))
+			for index, value := range flag.values {
+				values[index] = strings.ReplaceAll(value, " ", `\ `)
+			}
+
+			line += fmt.Sprintf(" -x -a %s", fishQuote(strings.Join(values, " ")))
+		case flag.takesValue:
+			line += " -r"
+		}
+
+		builder.WriteString(line + "\n")
+	}
+
+	return []byte(builder.String())
+}
+
+// fishQuote returns the text single-quoted for fish, which only treats backslashes and single quotes as special.
+func fishQuote(text string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(text) + "'"
+}
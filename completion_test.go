@@ -0,0 +1,82 @@
+package bconf_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigGenerateCompletion(t *testing.T) {
+	appConfig := bconf.NewAppConfig("my-app", "description")
+
+	if _, err := appConfig.GenerateCompletion(bconf.CompletionShellBash); err == nil {
+		t.Errorf("expected error generating completion without a flag loader")
+	}
+
+	_ = appConfig.SetLoaders(&bconf.FlagLoader{KeyPrefix: "ext"})
+	appConfig.SetHandlePrintConfigFlag(true)
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("log").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Description("Logging level [default: info]").
+			Enumeration("debug", "info", "very verbose").Create(),
+		bconf.FB().Key("color").Type(bconf.Bool).Create(),
+		bconf.FB().Key("path").Type(bconf.String).Description("Log file path").Create(),
+	).Create())
+
+	tests := []struct {
+		shell    string
+		expected []string
+	}{
+		{
+			shell: bconf.CompletionShellBash,
+			expected: []string{
+				"_my_app() {",
+				"\t--ext_log_level)\n\t\tlocal IFS=$'\\n'\n" +
+					"\t\tCOMPREPLY=($(compgen -W \"$(printf '%s\\n' debug info 'very verbose')\" -- \"${cur}\"))\n",
+				"\t--print-config)\n\t\tlocal IFS=$'\\n'\n" +
+					"\t\tCOMPREPLY=($(compgen -W \"$(printf '%s\\n' text json)\" -- \"${cur}\"))\n",
+				"compgen -W '--ext_log_level --ext_log_color --ext_log_path --help --print-config -h'",
+				"complete -F _my_app my-app\n",
+			},
+		},
+		{
+			shell: bconf.CompletionShellZsh,
+			expected: []string{
+				"#compdef my-app\n",
+				"'--ext_log_level=[Logging level \\[default\\: info\\]]:value:(debug info very\\ verbose)'",
+				"'--print-config=-[Print the effective configuration]:format:(text json)'",
+				"'--ext_log_color[bool]'",
+				"'--ext_log_path=[Log file path]:value: '",
+				"'(-h --help)-h[Show help]'",
+				"compdef _my_app my-app\n",
+			},
+		},
+		{
+			shell: bconf.CompletionShellFish,
+			expected: []string{
+				"complete -c my-app -l ext_log_level -d 'Logging level [default: info]' -x -a 'debug info very\\\\ verbose'\n",
+				"complete -c my-app -l ext_log_color -d 'bool'\n",
+				"complete -c my-app -l ext_log_path -d 'Log file path' -r\n",
+				"complete -c my-app -l help -s h -d 'Show help'\n",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		script, err := appConfig.GenerateCompletion(test.shell)
+		if err != nil {
+			t.Fatalf("unexpected error generating %s completion: %s", test.shell, err)
+		}
+
+		for _, expected := range test.expected {
+			if !strings.Contains(string(script), expected) {
+				t.Errorf("expected %s completion to contain '%s':\n%s", test.shell, expected, script)
+			}
+		}
+	}
+
+	if _, err := appConfig.GenerateCompletion("powershell"); err == nil {
+		t.Errorf("expected error generating completion for unsupported shell")
+	}
+}
@@ -1,5 +1,412 @@
 package bconf
 
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
 type ConfigStruct struct {
 	FieldSet string
 }
+
+// fieldValueLookup returns a field value, whether the field value is set, and an error if the field cannot be found.
+type fieldValueLookup func(fieldSetKey, fieldKey string) (value any, set bool, err error)
+
+type structFiller struct {
+	lookup fieldValueLookup
+	// fieldSetKeys are the field-set keys in the order they were added, matched by slices of structs
+	fieldSetKeys []string
+	// filling contains the struct types being filled, from the config struct to the current nested struct
+	filling map[reflect.Type]bool
+}
+
+var (
+	configStructType    = reflect.TypeOf(ConfigStruct{})
+	timeType            = reflect.TypeOf(time.Time{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// fillStruct fills the fields of a struct with values from the field value lookup. Struct fields are mapped to the
+// field-set of the embedded ConfigStruct, or to another field-set with a 'field-set.field' tag. Nested structs, and
+// pointers to structs, are mapped to the field-set named by their tag, and slices of structs are filled from every
+// field-set matching the tag pattern, which is required. Embedded structs are filled from the field-set of the parent
+// struct. Recursive struct types, e.g. a struct with a pointer to its own type, cannot be filled.
+func fillStruct(configStruct any, fieldSetKeys []string, lookup fieldValueLookup) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("problem filling struct: %s", r)
+		}
+	}()
+
+	if reflect.TypeOf(configStruct).Kind() != reflect.Pointer {
+		return fmt.Errorf("FillStruct expects a pointer to a struct, found '%s'", reflect.TypeOf(configStruct).Kind())
+	}
+
+	configStructValue := reflect.Indirect(reflect.ValueOf(configStruct))
+
+	if configStructValue.Kind() != reflect.Struct {
+		return fmt.Errorf("FillStruct expects a pointer to a struct, found pointer to '%s'", configStructValue.Kind())
+	}
+
+	filler := structFiller{lookup: lookup, fieldSetKeys: fieldSetKeys, filling: map[reflect.Type]bool{}}
+
+	return filler.fillStructValue(configStructValue, "", "")
+}
+
+// fillStructValue fills the fields of a struct value. The field-set is taken from the ConfigStruct FieldSet value,
+// the tagged field-set key, the ConfigStruct tag, or the default field-set key, in that order of precedence.
+func (f *structFiller) fillStructValue(structValue reflect.Value, taggedFieldSetKey, defaultFieldSetKey string) error {
+	structType := structValue.Type()
+	baseFieldSet := taggedFieldSetKey
+
+	f.filling[structType] = true
+	defer delete(f.filling, structType)
+
+	configStructField, found := structType.FieldByName("ConfigStruct")
+	if found && len(configStructField.Index) == 1 && configStructField.Type == configStructType {
+		if baseFieldSet == "" {
+			baseFieldSet = configStructField.Tag.Get("bconf")
+		}
+
+		overrideValue := structValue.Field(configStructField.Index[0]).FieldByName("FieldSet")
+		if overrideValue.String() != "" {
+			baseFieldSet = overrideValue.String()
+		}
+	}
+
+	if baseFieldSet == "" {
+		baseFieldSet = defaultFieldSetKey
+	}
+
+	for i := 0; i < structValue.NumField(); i++ {
+		field := structType.Field(i)
+
+		if field.Name == "ConfigStruct" && field.Type == configStructType {
+			continue
+		}
+
+		fieldTagValue := field.Tag.Get("bconf")
+		if fieldTagValue == "-" {
+			continue
+		}
+
+		// exported fields of embedded structs with unexported types are settable, and are filled
+		if !field.IsExported() && !(field.Anonymous && isNestedStruct(field.Type)) {
+			if fieldTagValue != "" {
+				return fmt.Errorf("cannot fill unexported struct field '%s'", field.Name)
+			}
+
+			continue
+		}
+
+		location := strings.Split(fieldTagValue, ",")[0]
+
+		if err := f.fillStructField(structValue.Field(i), field, location, baseFieldSet); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *structFiller) fillStructField(
+	fieldValue reflect.Value,
+	field reflect.StructField,
+	location, baseFieldSet string,
+) error {
+	fieldType := field.Type
+
+	switch {
+	case isNestedStruct(fieldType), fieldType.Kind() == reflect.Pointer && isNestedStruct(fieldType.Elem()):
+		if strings.Contains(location, ".") {
+			return fmt.Errorf("struct field '%s' tag must be a field-set key, found '%s'", field.Name, location)
+		}
+
+		if err := f.checkRecursiveType(field); err != nil {
+			return err
+		}
+
+		if fieldType.Kind() == reflect.Pointer {
+			if fieldValue.IsNil() {
+				fieldValue.Set(reflect.New(fieldType.Elem()))
+			}
+
+			fieldValue = fieldValue.Elem()
+		}
+
+		defaultFieldSetKey := field.Name
+		if field.Anonymous {
+			defaultFieldSetKey = baseFieldSet
+		}
+
+		if err := f.fillStructValue(fieldValue, location, defaultFieldSetKey); err != nil {
+			return fmt.Errorf("problem filling struct field '%s': %w", field.Name, err)
+		}
+
+		return nil
+	case fieldType.Kind() == reflect.Slice && isNestedStruct(structElemType(fieldType.Elem())):
+		return f.fillStructSlice(fieldValue, field, location)
+	}
+
+	fieldKey := field.Name
+	fieldSetKey := baseFieldSet
+
+	if location != "" {
+		fieldLocation := strings.Split(location, ".")
+
+		fieldKey = fieldLocation[0]
+
+		// NOTE: error if fieldLocation format isn't <field>.<field-name> ?
+		if len(fieldLocation) > 1 {
+			fieldSetKey = fieldLocation[0]
+			fieldKey = fieldLocation[1]
+		}
+	}
+
+	if fieldSetKey == "" {
+		return fmt.Errorf("unidentified field-set for field: %s", fieldKey)
+	}
+
+	val, set, err := f.lookup(fieldSetKey, fieldKey)
+	if err != nil {
+		return fmt.Errorf("problem getting field '%s.%s': %w", fieldSetKey, fieldKey, err)
+	} else if !set {
+		return nil
+	}
+
+	if err := setStructFieldValue(fieldValue, val); err != nil {
+		return fmt.Errorf(
+			"problem setting struct field '%s' from field '%s.%s': %w", field.Name, fieldSetKey, fieldKey, err,
+		)
+	}
+
+	return nil
+}
+
+// fillStructSlice fills a slice of structs, or of pointers to structs, with an element for every field-set with a key
+// matching the tag pattern (see path.Match), in the order the field-sets were added.
+func (f *structFiller) fillStructSlice(fieldValue reflect.Value, field reflect.StructField, location string) error {
+	pattern := location
+	if pattern == "" {
+		return fmt.Errorf("struct field '%s' slice of structs must be tagged with a field-set pattern", field.Name)
+	}
+
+	if err := f.checkRecursiveType(field); err != nil {
+		return err
+	}
+
+	elemType := field.Type.Elem()
+	slice := reflect.MakeSlice(field.Type, 0, len(f.fieldSetKeys))
+
+	for _, fieldSetKey := range f.fieldSetKeys {
+		matched, err := path.Match(pattern, fieldSetKey)
+		if err != nil {
+			return fmt.Errorf("invalid field-set pattern '%s' for struct field '%s': %w", pattern, field.Name, err)
+		} else if !matched {
+			continue
+		}
+
+		elem := reflect.New(structElemType(elemType))
+
+		if err := f.fillStructValue(elem.Elem(), fieldSetKey, fieldSetKey); err != nil {
+			return fmt.Errorf("problem filling struct field '%s' from field-set '%s': %w", field.Name, fieldSetKey, err)
+		}
+
+		if elemType.Kind() == reflect.Pointer {
+			slice = reflect.Append(slice, elem)
+		} else {
+			slice = reflect.Append(slice, elem.Elem())
+		}
+	}
+
+	fieldValue.Set(slice)
+
+	return nil
+}
+
+// checkRecursiveType returns an error when the struct type of a nested struct, struct pointer, or struct slice field is
+// already being filled, which would otherwise be filled without end.
+func (f *structFiller) checkRecursiveType(field reflect.StructField) error {
+	structType := structElemType(field.Type)
+	if field.Type.Kind() == reflect.Slice {
+		structType = structElemType(field.Type.Elem())
+	}
+
+	if f.filling[structType] {
+		return fmt.Errorf("struct field '%s' has recursive type '%s'", field.Name, structType)
+	}
+
+	return nil
+}
+
+// setStructFieldValue sets a struct field to a field value, converting the value to the struct field type when it
+// cannot be assigned directly (see convertStructFieldValue).
+func setStructFieldValue(fieldValue reflect.Value, val any) error {
+	value := reflect.ValueOf(val)
+	if !value.IsValid() {
+		return nil
+	}
+
+	convertedValue, err := convertStructFieldValue(value, fieldValue.Type())
+	if err != nil {
+		return err
+	}
+
+	fieldValue.Set(convertedValue)
+
+	return nil
+}
+
+// convertStructFieldValue converts a field value to a struct field type. Pointer types are allocated to hold the
+// converted value, so pointer struct fields stay nil when a field value is not set. Types implementing
+// encoding.TextUnmarshaler are unmarshaled from the formatted value, named types are converted from values of the same
+// kind, numeric values are converted between numeric types when the value fits the type, and slices are converted
+// element by element.
+func convertStructFieldValue(value reflect.Value, targetType reflect.Type) (reflect.Value, error) {
+	valueType := value.Type()
+
+	switch {
+	case valueType.AssignableTo(targetType):
+		return value, nil
+	case targetType.Kind() == reflect.Pointer:
+		elem, err := convertStructFieldValue(value, targetType.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		pointer := reflect.New(targetType.Elem())
+		pointer.Elem().Set(elem)
+
+		return pointer, nil
+	case reflect.PointerTo(targetType).Implements(textUnmarshalerType):
+		target := reflect.New(targetType)
+		text := structFieldText(value)
+
+		if err := target.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text)); err != nil {
+			return reflect.Value{}, fmt.Errorf("problem unmarshaling '%s' into type '%s': %w", text, targetType, err)
+		}
+
+		return target.Elem(), nil
+	case isNumericKind(valueType.Kind()) && isNumericKind(targetType.Kind()):
+		return convertNumericValue(value, targetType)
+	case valueType.Kind() == reflect.Slice && targetType.Kind() == reflect.Slice:
+		slice := reflect.MakeSlice(targetType, value.Len(), value.Len())
+
+		for index := 0; index < value.Len(); index++ {
+			elem, err := convertStructFieldValue(value.Index(index), targetType.Elem())
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("problem converting element %d: %w", index, err)
+			}
+
+			slice.Index(index).Set(elem)
+		}
+
+		return slice, nil
+	case valueType.Kind() == targetType.Kind() && valueType.ConvertibleTo(targetType):
+		// named types, e.g. 'type LogLevel string', or 'type Timestamp time.Time'
+		return value.Convert(targetType), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot assign value of type '%s' to type '%s'", valueType, targetType)
+}
+
+// convertNumericValue converts a numeric value to a numeric type, returning an error when the value overflows the
+// type, is negative and converted to an unsigned type, or has a fractional part and is converted to an integer type.
+func convertNumericValue(value reflect.Value, targetType reflect.Type) (reflect.Value, error) {
+	target := reflect.New(targetType).Elem()
+	overflow := false
+
+	switch {
+	case isFloatKind(value.Kind()) && !isFloatKind(targetType.Kind()):
+		floatValue := value.Float()
+		if floatValue != math.Trunc(floatValue) {
+			return reflect.Value{}, fmt.Errorf("cannot convert value '%v' to integer type '%s'", floatValue, targetType)
+		}
+
+		if floatValue < math.MinInt64 || floatValue >= math.MaxInt64 {
+			return reflect.Value{}, fmt.Errorf("value '%v' overflows type '%s'", floatValue, targetType)
+		}
+
+		return convertNumericValue(reflect.ValueOf(int64(floatValue)), targetType)
+	case isFloatKind(targetType.Kind()):
+		floatValue := numericFloat(value)
+		overflow = target.OverflowFloat(floatValue)
+		target.SetFloat(floatValue)
+	case isUintKind(value.Kind()) && isUintKind(targetType.Kind()):
+		overflow = target.OverflowUint(value.Uint())
+		target.SetUint(value.Uint())
+	case isUintKind(value.Kind()):
+		overflow = value.Uint() > math.MaxInt64 || target.OverflowInt(int64(value.Uint()))
+		target.SetInt(int64(value.Uint()))
+	case isUintKind(targetType.Kind()):
+		overflow = value.Int() < 0 || target.OverflowUint(uint64(value.Int()))
+		target.SetUint(uint64(value.Int()))
+	default:
+		overflow = target.OverflowInt(value.Int())
+		target.SetInt(value.Int())
+	}
+
+	if overflow {
+		return reflect.Value{}, fmt.Errorf("value '%v' overflows type '%s'", value.Interface(), targetType)
+	}
+
+	return target, nil
+}
+
+func numericFloat(value reflect.Value) float64 {
+	switch {
+	case isFloatKind(value.Kind()):
+		return value.Float()
+	case isUintKind(value.Kind()):
+		return float64(value.Uint())
+	default:
+		return float64(value.Int())
+	}
+}
+
+// structFieldText formats a field value as text for encoding.TextUnmarshaler struct fields.
+func structFieldText(value reflect.Value) string {
+	if timeValue, ok := value.Interface().(time.Time); ok {
+		return timeValue.Format(time.RFC3339Nano)
+	}
+
+	return fmt.Sprintf("%v", value.Interface())
+}
+
+func isNumericKind(kind reflect.Kind) bool {
+	return isFloatKind(kind) || isUintKind(kind) || (kind >= reflect.Int && kind <= reflect.Int64)
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isUintKind(kind reflect.Kind) bool {
+	return kind >= reflect.Uint && kind <= reflect.Uintptr
+}
+
+// isNestedStruct returns whether a struct field type is filled from a field-set, rather than from a field value.
+// Structs implementing encoding.TextUnmarshaler, and time types, are filled from a field value.
+func isNestedStruct(fieldType reflect.Type) bool {
+	return fieldType.Kind() == reflect.Struct && !isTimeType(fieldType) && fieldType != configStructType &&
+		!reflect.PointerTo(fieldType).Implements(textUnmarshalerType)
+}
+
+// isTimeType returns whether a type is time.Time, or a named type converted from it, e.g. 'type Timestamp time.Time'.
+func isTimeType(fieldType reflect.Type) bool {
+	return fieldType.Kind() == reflect.Struct && fieldType.ConvertibleTo(timeType)
+}
+
+// structElemType returns the element type of a pointer type, or the type itself.
+func structElemType(fieldType reflect.Type) reflect.Type {
+	if fieldType.Kind() == reflect.Pointer {
+		return fieldType.Elem()
+	}
+
+	return fieldType
+}
@@ -0,0 +1,330 @@
+package bconf_test
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rheisen/bconf"
+)
+
+//nolint:govet // doesn't need to be optimal for tests
+type fillTestDBConfig struct {
+	Host string `bconf:"host"`
+	Port int    `bconf:"port"`
+}
+
+//nolint:govet // doesn't need to be optimal for tests
+type fillTestTimeouts struct {
+	ReadTimeout time.Duration `bconf:"read_timeout"`
+}
+
+//nolint:govet // doesn't need to be optimal for tests
+type fillTestAPIConfig struct {
+	bconf.ConfigStruct `bconf:"fill_api"`
+	fillTestTimeouts
+	Host string `bconf:"host"`
+}
+
+//nolint:govet // doesn't need to be optimal for tests
+type fillTestAppConfig struct {
+	API      fillTestAPIConfig   `bconf:"fill_api"`
+	DB       *fillTestDBConfig   `bconf:"fill_db"`
+	Replicas []fillTestDBConfig  `bconf:"fill_replica_*"`
+	Pointers []*fillTestDBConfig `bconf:"fill_replica_*"`
+	Started  time.Time           `bconf:"fill_api.started"`
+	Ignored  *fillTestDBConfig   `bconf:"-"`
+	internal string
+}
+
+func TestAppConfigFillStructNested(t *testing.T) {
+	appConfig := bconf.NewAppConfig("app", "description")
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	dbFieldSet := func(key, host string) *bconf.FieldSet {
+		return bconf.FSB().Key(key).Fields(
+			bconf.FB().Key("host").Type(bconf.String).Default(host).Create(),
+			bconf.FB().Key("port").Type(bconf.Int).Default(5432).Create(),
+		).Create()
+	}
+
+	errs := appConfig.AddFieldSets(
+		bconf.FSB().Key("fill_api").Fields(
+			bconf.FB().Key("host").Type(bconf.String).Default("localhost").Create(),
+			bconf.FB().Key("read_timeout").Type(bconf.Duration).Default(5*time.Second).Create(),
+			bconf.FB().Key("started").Type(bconf.Time).Default(started).Create(),
+		).Create(),
+		dbFieldSet("fill_db", "db.local"),
+		dbFieldSet("fill_replica_b", "replica-b.local"),
+		dbFieldSet("fill_replica_a", "replica-a.local"),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	configStruct := &fillTestAppConfig{}
+	if err := appConfig.FillStruct(configStruct); err != nil {
+		t.Fatalf("unexpected error filling struct: %s", err)
+	}
+
+	if configStruct.API.Host != "localhost" || configStruct.API.ReadTimeout != 5*time.Second {
+		t.Errorf("unexpected nested struct values: %+v", configStruct.API)
+	}
+
+	if configStruct.DB == nil || configStruct.DB.Host != "db.local" || configStruct.DB.Port != 5432 {
+		t.Errorf("unexpected nested struct pointer values: %+v", configStruct.DB)
+	}
+
+	if len(configStruct.Replicas) != 2 || configStruct.Replicas[0].Host != "replica-b.local" ||
+		configStruct.Replicas[1].Host != "replica-a.local" {
+		t.Errorf("unexpected struct slice values: %+v", configStruct.Replicas)
+	}
+
+	if len(configStruct.Pointers) != 2 || configStruct.Pointers[1].Host != "replica-a.local" {
+		t.Errorf("unexpected struct pointer slice values: %+v", configStruct.Pointers)
+	}
+
+	if !configStruct.Started.Equal(started) {
+		t.Errorf("unexpected time value: %s", configStruct.Started)
+	}
+
+	if configStruct.Ignored != nil {
+		t.Errorf("unexpected value for ignored struct field: %+v", configStruct.Ignored)
+	}
+
+	snapshotStruct := &fillTestAppConfig{}
+	if err := appConfig.Snapshot().FillStruct(snapshotStruct); err != nil {
+		t.Fatalf("unexpected error filling struct from snapshot: %s", err)
+	}
+
+	if len(snapshotStruct.Replicas) != 2 || snapshotStruct.Replicas[0].Host != "replica-b.local" {
+		t.Errorf("unexpected struct slice values from snapshot: %+v", snapshotStruct.Replicas)
+	}
+}
+
+func TestAppConfigFillStructNestedErrors(t *testing.T) {
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("fill_err").Fields(
+		bconf.FB().Key("host").Type(bconf.String).Default("localhost").Create(),
+	).Create())
+
+	missingFieldSet := &struct {
+		DB fillTestDBConfig `bconf:"fill_missing"`
+	}{}
+	if err := appConfig.FillStruct(missingFieldSet); err == nil ||
+		!strings.Contains(err.Error(), "problem filling struct field 'DB'") {
+		t.Errorf("expected error filling nested struct from missing field-set, got: %v", err)
+	}
+
+	mismatchedType := &struct {
+		bconf.ConfigStruct `bconf:"fill_err"`
+		Host               int `bconf:"host"`
+	}{}
+	if err := appConfig.FillStruct(mismatchedType); err == nil ||
+		!strings.Contains(err.Error(), "cannot assign value of type 'string' to type 'int'") {
+		t.Errorf("expected error filling struct field with mismatched type, got: %v", err)
+	}
+
+	invalidTag := &struct {
+		DB fillTestDBConfig `bconf:"fill_err.host"`
+	}{}
+	if err := appConfig.FillStruct(invalidTag); err == nil {
+		t.Errorf("expected error filling nested struct with field tag")
+	}
+
+	recursivePointer := &fillTestNode{}
+	if err := appConfig.FillStruct(recursivePointer); err == nil ||
+		!strings.Contains(err.Error(), "struct field 'Next' has recursive type 'bconf_test.fillTestNode'") {
+		t.Errorf("expected error filling recursive struct pointer, got: %v", err)
+	}
+
+	recursiveSlice := &struct {
+		Nodes []fillTestNode `bconf:"fill_*"`
+	}{}
+	if err := appConfig.FillStruct(recursiveSlice); err == nil ||
+		!strings.Contains(err.Error(), "has recursive type 'bconf_test.fillTestNode'") {
+		t.Errorf("expected error filling slice of recursive structs, got: %v", err)
+	}
+
+	untaggedSlice := &struct {
+		bconf.ConfigStruct `bconf:"fill_err"`
+		Replicas           []fillTestDBConfig
+	}{}
+	if err := appConfig.FillStruct(untaggedSlice); err == nil ||
+		!strings.Contains(err.Error(), "struct field 'Replicas' slice of structs must be tagged") {
+		t.Errorf("expected error filling untagged slice of structs, got: %v", err)
+	}
+}
+
+type fillTestNode struct {
+	bconf.ConfigStruct `bconf:"fill_err"`
+	Host               string        `bconf:"host"`
+	Next               *fillTestNode `bconf:"fill_err"`
+}
+
+type fillTestLogLevel string
+
+type fillTestTimestamp time.Time
+
+type fillTestHostPort struct {
+	Host string
+	Port string
+}
+
+func (h *fillTestHostPort) UnmarshalText(text []byte) error {
+	host, port, found := strings.Cut(string(text), ":")
+	if !found {
+		return fmt.Errorf("missing port in address '%s'", text)
+	}
+
+	h.Host, h.Port = host, port
+
+	return nil
+}
+
+//nolint:govet // doesn't need to be optimal for tests
+type fillTestConvertedConfig struct {
+	bconf.ConfigStruct `bconf:"fill_convert"`
+	LogLevel           fillTestLogLevel   `bconf:"log_level"`
+	Levels             []fillTestLogLevel `bconf:"levels"`
+	Port               uint16             `bconf:"port"`
+	Workers            int64              `bconf:"workers"`
+	Ratio              float32            `bconf:"ratio"`
+	Retries            int8               `bconf:"ratio_whole"`
+	Name               *string            `bconf:"name"`
+	Unset              *string            `bconf:"unset"`
+	Address            fillTestHostPort   `bconf:"address"`
+	AddressPointer     *fillTestHostPort  `bconf:"address"`
+	IP                 net.IP             `bconf:"ip"`
+	Timeout            *time.Duration     `bconf:"timeout"`
+	Started            fillTestTimestamp  `bconf:"started"`
+	StartedPointer     *fillTestTimestamp `bconf:"started"`
+}
+
+func TestAppConfigFillStructConversion(t *testing.T) {
+	appConfig := bconf.NewAppConfig("app", "description")
+
+	errs := appConfig.AddFieldSets(bconf.FSB().Key("fill_convert").Fields(
+		bconf.FB().Key("log_level").Type(bconf.String).Default("debug").Create(),
+		bconf.FB().Key("levels").Type(bconf.Strings).Default([]string{"info", "warn"}).Create(),
+		bconf.FB().Key("port").Type(bconf.Int).Default(8080).Create(),
+		bconf.FB().Key("workers").Type(bconf.Int).Default(4).Create(),
+		bconf.FB().Key("ratio").Type(bconf.Float).Default(0.5).Create(),
+		bconf.FB().Key("ratio_whole").Type(bconf.Float).Default(3.0).Create(),
+		bconf.FB().Key("name").Type(bconf.String).Default("service").Create(),
+		bconf.FB().Key("unset").Type(bconf.String).Create(),
+		bconf.FB().Key("address").Type(bconf.String).Default("localhost:5432").Create(),
+		bconf.FB().Key("ip").Type(bconf.String).Default("10.0.0.1").Create(),
+		bconf.FB().Key("timeout").Type(bconf.Duration).Default(time.Second).Create(),
+		bconf.FB().Key("started").Type(bconf.Time).Default(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)).Create(),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	configStruct := &fillTestConvertedConfig{}
+	if err := appConfig.FillStruct(configStruct); err != nil {
+		t.Fatalf("unexpected error filling struct: %s", err)
+	}
+
+	if configStruct.LogLevel != "debug" || len(configStruct.Levels) != 2 || configStruct.Levels[1] != "warn" {
+		t.Errorf("unexpected named type values: %v, %v", configStruct.LogLevel, configStruct.Levels)
+	}
+
+	if configStruct.Port != 8080 || configStruct.Workers != 4 || configStruct.Ratio != 0.5 ||
+		configStruct.Retries != 3 {
+		t.Errorf("unexpected numeric values: %+v", configStruct)
+	}
+
+	if configStruct.Name == nil || *configStruct.Name != "service" {
+		t.Errorf("unexpected pointer value: %v", configStruct.Name)
+	}
+
+	if configStruct.Unset != nil {
+		t.Errorf("expected unset pointer value to be nil, found: %v", *configStruct.Unset)
+	}
+
+	if configStruct.Address.Host != "localhost" || configStruct.Address.Port != "5432" {
+		t.Errorf("unexpected text unmarshaler value: %+v", configStruct.Address)
+	}
+
+	if configStruct.AddressPointer == nil || configStruct.AddressPointer.Port != "5432" {
+		t.Errorf("unexpected text unmarshaler pointer value: %+v", configStruct.AddressPointer)
+	}
+
+	if !configStruct.IP.Equal(net.IPv4(10, 0, 0, 1)) {
+		t.Errorf("unexpected net.IP value: %s", configStruct.IP)
+	}
+
+	if configStruct.Timeout == nil || *configStruct.Timeout != time.Second {
+		t.Errorf("unexpected duration pointer value: %v", configStruct.Timeout)
+	}
+
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	if !time.Time(configStruct.Started).Equal(started) {
+		t.Errorf("unexpected named time value: %s", time.Time(configStruct.Started))
+	}
+
+	if configStruct.StartedPointer == nil || !time.Time(*configStruct.StartedPointer).Equal(started) {
+		t.Errorf("unexpected named time pointer value: %v", configStruct.StartedPointer)
+	}
+}
+
+func TestAppConfigFillStructConversionErrors(t *testing.T) {
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("fill_convert_err").Fields(
+		bconf.FB().Key("large").Type(bconf.Int).Default(70000).Create(),
+		bconf.FB().Key("negative").Type(bconf.Int).Default(-1).Create(),
+		bconf.FB().Key("fraction").Type(bconf.Float).Default(1.5).Create(),
+		bconf.FB().Key("address").Type(bconf.String).Default("localhost").Create(),
+	).Create())
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	overflow := &struct {
+		bconf.ConfigStruct `bconf:"fill_convert_err"`
+		Large              uint16 `bconf:"large"`
+	}{}
+	if err := appConfig.FillStruct(overflow); err == nil ||
+		!strings.Contains(err.Error(), "value '70000' overflows type 'uint16'") {
+		t.Errorf("expected overflow error, got: %v", err)
+	}
+
+	negative := &struct {
+		bconf.ConfigStruct `bconf:"fill_convert_err"`
+		Negative           uint `bconf:"negative"`
+	}{}
+	if err := appConfig.FillStruct(negative); err == nil || !strings.Contains(err.Error(), "overflows type 'uint'") {
+		t.Errorf("expected negative unsigned value error, got: %v", err)
+	}
+
+	fraction := &struct {
+		bconf.ConfigStruct `bconf:"fill_convert_err"`
+		Fraction           int `bconf:"fraction"`
+	}{}
+	if err := appConfig.FillStruct(fraction); err == nil ||
+		!strings.Contains(err.Error(), "cannot convert value '1.5' to integer type 'int'") {
+		t.Errorf("expected fractional value error, got: %v", err)
+	}
+
+	unmarshal := &struct {
+		bconf.ConfigStruct `bconf:"fill_convert_err"`
+		Address            fillTestHostPort `bconf:"address"`
+	}{}
+	if err := appConfig.FillStruct(unmarshal); err == nil ||
+		!strings.Contains(err.Error(), "missing port in address 'localhost'") {
+		t.Errorf("expected text unmarshaler error, got: %v", err)
+	}
+}
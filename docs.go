@@ -0,0 +1,250 @@
+package bconf
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+const (
+	// DocsFormatMarkdown generates a Markdown configuration reference, with a table of fields for every field-set
+	DocsFormatMarkdown = "markdown"
+	// DocsFormatHTML generates a standalone HTML configuration reference document
+	DocsFormatHTML = "html"
+)
+
+var docsColumns = []string{
+	"Field", "Description", "Type", "Default", "Accepted Values", "Required", "Loaded When", "Keys",
+}
+
+type docsFieldSet struct {
+	key    string
+	notes  []string
+	fields [][]string
+}
+
+// GenerateDocs generates a configuration reference for every field-set and field in the order they were added, with
+// field descriptions, types, defaults, accepted values, required status, load-condition dependencies, and the keys used
+// by each loader.
+func (c *AppConfig) GenerateDocs(format string) ([]byte, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	fieldSets := c.docsFieldSets()
+
+	switch format {
+	case DocsFormatMarkdown:
+		return c.markdownDocs(fieldSets), nil
+	case DocsFormatHTML:
+		return c.htmlDocs(fieldSets), nil
+	default:
+		return nil, fmt.Errorf("unsupported docs format: '%s'", format)
+	}
+}
+
+// -- Private methods --
+
+func (c *AppConfig) docsFieldSets() []docsFieldSet {
+	fieldSets := make([]docsFieldSet, 0, len(c.orderedFieldSets))
+
+	for _, fieldSet := range c.orderedFieldSets {
+		docsSet := docsFieldSet{key: fieldSet.Key}
+
+		if len(fieldSet.Profiles) > 0 {
+			docsSet.notes = append(docsSet.notes, fmt.Sprintf("Loaded in profiles: %s", strings.Join(fieldSet.Profiles, ", ")))
+		}
+
+		for _, condition := range fieldSet.LoadConditions {
+			docsSet.notes = append(docsSet.notes, fmt.Sprintf("Loaded when %s", loadConditionSummary(condition)))
+		}
+
+		for _, field := range fieldSet.orderedFields() {
+			docsSet.fields = append(docsSet.fields, c.docsFieldColumns(fieldSet.Key, field))
+		}
+
+		fieldSets = append(fieldSets, docsSet)
+	}
+
+	return fieldSets
+}
+
+// docsFieldColumns returns the docs table cells of a field, in docsColumns order, with multiple values in a cell
+// separated by newlines.
+func (c *AppConfig) docsFieldColumns(fieldSetKey string, field *Field) []string {
+	description := field.Description
+
+	if fieldSetKey == c.profileFieldSet && field.Key == c.profileField {
+		description = strings.TrimSpace(description + "\nSelects the configuration profile")
+	}
+
+	if note := field.deprecationNote(); note != "" {
+		description = strings.TrimSpace(description + "\n" + note)
+	}
+
+	defaults := []string{}
+
+	for _, fieldDefault := range field.displayDefaults() {
+		if fieldDefault.profile != "" {
+			defaults = append(defaults, fmt.Sprintf("%s (%s)", fieldDefault.value, fieldDefault.profile))
+		} else {
+			defaults = append(defaults, fieldDefault.value)
+		}
+	}
+
+	accepted := ""
+	if len(field.Enumeration) > 0 {
+		accepted = field.enumerationString()
+	}
+
+	required := "No"
+
+	switch {
+	case field.Required:
+		required = "Yes"
+	case len(field.RequiredInProfiles) > 0:
+		required = fmt.Sprintf("In profiles: %s", strings.Join(field.RequiredInProfiles, ", "))
+	}
+
+	conditions := []string{}
+	for _, condition := range field.LoadConditions {
+		conditions = append(conditions, loadConditionSummary(condition))
+	}
+
+	keys := []string{}
+
+	for _, loader := range c.loaders {
+		if helpString := loader.HelpString(fieldSetKey, field.Key); helpString != "" {
+			keys = append(keys, helpString)
+		}
+
+		for _, alias := range field.Aliases {
+			if aliasHelpString := loader.HelpString(field.aliasLocation(alias, fieldSetKey)); aliasHelpString != "" {
+				keys = append(keys, fmt.Sprintf("%s (deprecated alias)", aliasHelpString))
+			}
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("%s_%s", fieldSetKey, field.Key),
+		description,
+		field.Type,
+		strings.Join(defaults, "\n"),
+		accepted,
+		required,
+		strings.Join(conditions, "\n"),
+		strings.Join(keys, "\n"),
+	}
+}
+
+func (c *AppConfig) markdownDocs(fieldSets []docsFieldSet) []byte {
+	builder := strings.Builder{}
+
+	title := "Configuration Reference"
+	if c.appName != "" {
+		title = fmt.Sprintf("%s %s", c.appName, title)
+	}
+
+	builder.WriteString(fmt.Sprintf("# %s\n", title))
+
+	if c.appDescription != "" {
+		builder.WriteString(fmt.Sprintf("\n%s\n", c.appDescription))
+	}
+
+	for _, fieldSet := range fieldSets {
+		builder.WriteString(fmt.Sprintf("\n## %s\n\n", fieldSet.key))
+
+		for _, note := range fieldSet.notes {
+			builder.WriteString(fmt.Sprintf("%s\n\n", markdownEscape(note)))
+		}
+
+		builder.WriteString(fmt.Sprintf("| %s |\n", strings.Join(docsColumns, " | ")))
+		builder.WriteString(fmt.Sprintf("|%s\n", strings.Repeat(" --- |", len(docsColumns))))
+
+		for _, columns := range fieldSet.fields {
+			cells := make([]string, len(columns))
+			for index, column := range columns {
+				cells[index] = markdownEscape(column)
+			}
+
+			cells[0] = fmt.Sprintf("`%s`", columns[0])
+
+			builder.WriteString(fmt.Sprintf("| %s |\n", strings.Join(cells, " | ")))
+		}
+	}
+
+	return []byte(builder.String())
+}
+
+func (c *AppConfig) htmlDocs(fieldSets []docsFieldSet) []byte {
+	builder := strings.Builder{}
+
+	title := "Configuration Reference"
+	if c.appName != "" {
+		title = fmt.Sprintf("%s %s", c.appName, title)
+	}
+
+	builder.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	builder.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(title)))
+	builder.WriteString("<style>\n")
+	builder.WriteString("body { font-family: sans-serif; }\n")
+	builder.WriteString("table { border-collapse: collapse; }\n")
+	builder.WriteString("th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }\n")
+	builder.WriteString("</style>\n</head>\n<body>\n")
+	builder.WriteString(fmt.Sprintf("<h1>%s</h1>\n", html.EscapeString(title)))
+
+	if c.appDescription != "" {
+		builder.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(c.appDescription)))
+	}
+
+	for _, fieldSet := range fieldSets {
+		fieldSetKey := html.EscapeString(fieldSet.key)
+		builder.WriteString(fmt.Sprintf("<h2 id=\"%s\">%s</h2>\n", fieldSetKey, fieldSetKey))
+
+		for _, note := range fieldSet.notes {
+			builder.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(note)))
+		}
+
+		builder.WriteString("<table>\n<tr>")
+
+		for _, column := range docsColumns {
+			builder.WriteString(fmt.Sprintf("<th>%s</th>", column))
+		}
+
+		builder.WriteString("</tr>\n")
+
+		for _, columns := range fieldSet.fields {
+			builder.WriteString("<tr>")
+
+			for index, column := range columns {
+				cell := strings.ReplaceAll(html.EscapeString(column), "\n", "<br>")
+				if index == 0 {
+					cell = fmt.Sprintf("<code>%s</code>", cell)
+				}
+
+				builder.WriteString(fmt.Sprintf("<td>%s</td>", cell))
+			}
+
+			builder.WriteString("</tr>\n")
+		}
+
+		builder.WriteString("</table>\n")
+	}
+
+	builder.WriteString("</body>\n</html>\n")
+
+	return []byte(builder.String())
+}
+
+// markdownEscape escapes text for a Markdown table cell, replacing newlines with line breaks.
+func markdownEscape(text string) string {
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		"|", "\\|",
+		"<", "&lt;",
+		">", "&gt;",
+		"`", "\\`",
+		"\n", "<br>",
+	)
+
+	return replacer.Replace(text)
+}
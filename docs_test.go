@@ -0,0 +1,75 @@
+package bconf_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigGenerateDocs(t *testing.T) {
+	appConfig := bconf.NewAppConfig("app", "Application description")
+	_ = appConfig.SetLoaders(&bconf.EnvironmentLoader{KeyPrefix: "svc"}, &bconf.FlagLoader{})
+
+	errs := appConfig.AddFieldSets(
+		bconf.FSB().Key("docs_log").Fields(
+			bconf.FB().Key("level").Type(bconf.String).Description("Logging level | verbosity").
+				Enumeration("debug", "info").Default("info").Create(),
+			bconf.FB().Key("token").Type(bconf.String).Default("secret").Sensitive().Create(),
+		).Create(),
+		bconf.FSB().Key("docs_db").LoadConditions(bconf.IsSet("docs_log", "level")).Fields(
+			bconf.FB().Key("enabled").Type(bconf.Bool).Default(false).Create(),
+			bconf.FB().Key("host").Type(bconf.String).Required().
+				LoadConditions(bconf.IsTrue("", "enabled")).Create(),
+		).Create(),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	markdownDocs, err := appConfig.GenerateDocs(bconf.DocsFormatMarkdown)
+	if err != nil {
+		t.Fatalf("unexpected error generating markdown docs: %s", err)
+	}
+
+	for _, expected := range []string{
+		"# app Configuration Reference",
+		"## docs_log",
+		"| Field | Description | Type | Default | Accepted Values | Required | Loaded When | Keys |",
+		"| `docs_log_level` | Logging level \\| verbosity | string | info | ['debug', 'info'] | No |  | " +
+			"Environment key: 'SVC_DOCS_LOG_LEVEL'<br>Flag argument: '--docs_log_level' |",
+		"| `docs_log_token` |  | string | &lt;sensitive-value&gt; |",
+		"Loaded when docs_log.level is set",
+		"| `docs_db_host` |  | string |  |  | Yes | enabled is true |",
+	} {
+		if !strings.Contains(string(markdownDocs), expected) {
+			t.Errorf("expected markdown docs to contain '%s':\n%s", expected, markdownDocs)
+		}
+	}
+
+	if strings.Contains(string(markdownDocs), "secret") {
+		t.Errorf("unexpected sensitive value in markdown docs:\n%s", markdownDocs)
+	}
+
+	htmlDocs, err := appConfig.GenerateDocs(bconf.DocsFormatHTML)
+	if err != nil {
+		t.Fatalf("unexpected error generating html docs: %s", err)
+	}
+
+	for _, expected := range []string{
+		"<!DOCTYPE html>",
+		"<title>app Configuration Reference</title>",
+		"<h2 id=\"docs_db\">docs_db</h2>",
+		"<td><code>docs_log_level</code></td>",
+		"<td>[&#39;debug&#39;, &#39;info&#39;]</td>",
+		"Environment key: &#39;SVC_DOCS_LOG_LEVEL&#39;<br>Flag argument: &#39;--docs_log_level&#39;",
+	} {
+		if !strings.Contains(string(htmlDocs), expected) {
+			t.Errorf("expected html docs to contain '%s':\n%s", expected, htmlDocs)
+		}
+	}
+
+	if _, err := appConfig.GenerateDocs("unknown"); err == nil {
+		t.Errorf("expected error generating docs with unsupported format")
+	}
+}
@@ -16,6 +16,8 @@ func NewEnvironmentLoaderWithKeyPrefix(keyPrefix string) *EnvironmentLoader {
 
 type EnvironmentLoader struct {
 	KeyPrefix string
+	// Strict reports environment variables under the KeyPrefix namespace that do not map to a field
+	Strict bool
 }
 
 func (l *EnvironmentLoader) Clone() *EnvironmentLoader {
@@ -52,6 +54,39 @@ func (l *EnvironmentLoader) HelpString(fieldSetKey, fieldKey string) string {
 	return fmt.Sprintf("Environment key: '%s'", l.environmentKey(fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)))
 }
 
+func (l *EnvironmentLoader) SourceKey(fieldSetKey, fieldKey string) string {
+	return l.environmentKey(fmt.Sprintf("%s_%s", fieldSetKey, fieldKey))
+}
+
+// SourceKeys returns the environment variables starting with the loader KeyPrefix. Without a KeyPrefix the loader has
+// no namespace to inspect, and no keys are returned.
+func (l *EnvironmentLoader) SourceKeys() []string {
+	keys := []string{}
+
+	if l.KeyPrefix == "" {
+		return keys
+	}
+
+	prefix := l.environmentKey("")
+
+	for _, variable := range os.Environ() {
+		key := variable
+		if splitIndex := strings.Index(variable, "="); splitIndex > -1 {
+			key = variable[:splitIndex]
+		}
+
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func (l *EnvironmentLoader) StrictMode() bool {
+	return l.Strict
+}
+
 func (l *EnvironmentLoader) environmentKey(key string) string {
 	envKey := ""
 	if l.KeyPrefix != "" {
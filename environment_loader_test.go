@@ -179,3 +179,76 @@ func TestEnvironmentLoaderWithKeyPrefix(t *testing.T) {
 		t.Errorf("unexpected value for session_key from loader clone: '%s'", cloneSessionKeyLookup)
 	}
 }
+
+func TestEnvironmentLoaderSourceKeys(t *testing.T) {
+	t.Setenv("BCONF_SOURCE_KEYS_APP_ID", "app-id")
+	t.Setenv("BCONF_SOURCE_KEYS_APP_IDD", "app-id")
+	t.Setenv("OTHER_SOURCE_KEYS_APP_ID", "app-id")
+
+	loader := bconf.NewEnvironmentLoaderWithKeyPrefix("bconf_source_keys")
+
+	if sourceKey := loader.SourceKey("app", "id"); sourceKey != "BCONF_SOURCE_KEYS_APP_ID" {
+		t.Errorf("unexpected source key: '%s'", sourceKey)
+	}
+
+	sourceKeys := loader.SourceKeys()
+	if len(sourceKeys) != 2 {
+		t.Fatalf("unexpected source keys length '%d', expected '2': %v", len(sourceKeys), sourceKeys)
+	}
+
+	for _, sourceKey := range sourceKeys {
+		if !strings.HasPrefix(sourceKey, "BCONF_SOURCE_KEYS_") {
+			t.Errorf("unexpected source key outside of loader namespace: '%s'", sourceKey)
+		}
+	}
+
+	if sourceKeys := bconf.NewEnvironmentLoader().SourceKeys(); len(sourceKeys) != 0 {
+		t.Errorf("unexpected source keys for loader without key prefix: %v", sourceKeys)
+	}
+
+	if loader.StrictMode() {
+		t.Errorf("unexpected strict mode for loader")
+	}
+}
+
+func TestEnvironmentLoaderFloatFields(t *testing.T) {
+	t.Setenv("BCONF_FLOAT_TEST_METRICS_RATIO", "0.25")
+	t.Setenv("BCONF_FLOAT_TEST_METRICS_BUCKETS", "0.5, 1,2.75")
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.SetLoaders(bconf.NewEnvironmentLoaderWithKeyPrefix("bconf_float_test"))
+
+	errs := appConfig.AddFieldSet(bconf.FSB().Key("metrics").Fields(
+		bconf.FB().Key("ratio").Type(bconf.Float).Create(),
+		bconf.FB().Key("buckets").Type(bconf.Floats).Create(),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-set: %v", errs)
+	}
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	ratio, _ := appConfig.Explain("metrics", "ratio")
+	if value, ok := ratio.Value.(float64); !ok || value != 0.25 {
+		t.Errorf("unexpected float value: %v", ratio.Value)
+	}
+
+	buckets, _ := appConfig.Explain("metrics", "buckets")
+	if value, ok := buckets.Value.([]float64); !ok || len(value) != 3 || value[0] != 0.5 || value[2] != 2.75 {
+		t.Errorf("unexpected floats value: %v", buckets.Value)
+	}
+
+	t.Setenv("BCONF_FLOAT_TEST_METRICS_RATIO", "quarter")
+
+	invalidAppConfig := bconf.NewAppConfig("app", "description")
+	_ = invalidAppConfig.SetLoaders(bconf.NewEnvironmentLoaderWithKeyPrefix("bconf_float_test"))
+	_ = invalidAppConfig.AddFieldSet(bconf.FSB().Key("metrics").Fields(
+		bconf.FB().Key("ratio").Type(bconf.Float).Create(),
+	).Create())
+
+	if errs := invalidAppConfig.Register(false); len(errs) != 1 {
+		t.Errorf("expected error loading invalid float value, got: %v", errs)
+	}
+}
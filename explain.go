@@ -0,0 +1,124 @@
+package bconf
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	ValueSourceOverride         = "override"
+	ValueSourceProfileDefault   = "profile_default"
+	ValueSourceDefault          = "default"
+	ValueSourceGeneratedDefault = "generated_default"
+)
+
+// FieldExplanation describes where a field value comes from, with values from Sensitive fields redacted when
+// formatted.
+type FieldExplanation struct {
+	// Value is the effective field value
+	Value any
+	// Candidates are the values found by loaders, in precedence order (highest precedence first)
+	Candidates  []FieldValueCandidate
+	FieldSetKey string
+	FieldKey    string
+	// Source is the name of the loader the effective value was found by, one of the ValueSource constants, or empty
+	// when no value is set
+	Source string
+	// SourceKey is the key the effective value was found with when the source is a loader
+	SourceKey string
+	Sensitive bool
+}
+
+// FieldValueCandidate describes a value found by a loader for a field.
+type FieldValueCandidate struct {
+	Value any
+	// Loader is the name of the loader that found the value
+	Loader string
+	// SourceKey is the key the loader found the value with, e.g. an environment variable name or JSON attribute path
+	SourceKey string
+}
+
+func (e FieldExplanation) String() string {
+	builder := strings.Builder{}
+
+	switch {
+	case e.Source == "":
+		builder.WriteString(fmt.Sprintf("%s_%s: no value set\n", e.FieldSetKey, e.FieldKey))
+	case e.SourceKey != "":
+		builder.WriteString(fmt.Sprintf(
+			"%s_%s: '%v' from '%s' (%s)\n",
+			e.FieldSetKey,
+			e.FieldKey,
+			e.displayValue(e.Value),
+			e.Source,
+			e.SourceKey,
+		))
+	default:
+		builder.WriteString(fmt.Sprintf(
+			"%s_%s: '%v' from '%s'\n",
+			e.FieldSetKey,
+			e.FieldKey,
+			e.displayValue(e.Value),
+			e.Source,
+		))
+	}
+
+	for _, candidate := range e.Candidates {
+		builder.WriteString(fmt.Sprintf(
+			"\t%s (%s): '%v'\n",
+			candidate.Loader,
+			candidate.SourceKey,
+			e.displayValue(candidate.Value),
+		))
+	}
+
+	return builder.String()
+}
+
+// Explain returns where a field value comes from: the effective value and its source (an override, a loader, or a
+// default), along with every value found by loaders in precedence order and the keys they were found with.
+func (c *AppConfig) Explain(fieldSetKey, fieldKey string) (*FieldExplanation, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	field, err := c.getField(fieldSetKey, fieldKey)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &FieldExplanation{
+		FieldSetKey: fieldSetKey,
+		FieldKey:    fieldKey,
+		Source:      field.getValueSource(),
+		Sensitive:   field.Sensitive,
+		Candidates:  make([]FieldValueCandidate, 0, len(field.fieldFound)),
+	}
+
+	explanation.Value, _ = field.getValue()
+	explanation.SourceKey = field.fieldSourceKey[explanation.Source]
+
+	for index := len(field.fieldFound) - 1; index >= 0; index-- {
+		loaderName := field.fieldFound[index]
+
+		value, err := field.getValueFrom(loaderName)
+		if err != nil {
+			continue
+		}
+
+		explanation.Candidates = append(explanation.Candidates, FieldValueCandidate{
+			Loader:    loaderName,
+			SourceKey: field.fieldSourceKey[loaderName],
+			Value:     value,
+		})
+	}
+
+	return explanation, nil
+}
+
+func (e FieldExplanation) displayValue(value any) any {
+	if e.Sensitive {
+		return sensitiveValuePlaceholder
+	}
+
+	return value
+}
@@ -0,0 +1,86 @@
+package bconf_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigExplain(t *testing.T) {
+	appConfig := bconf.NewAppConfig("app", "description")
+
+	_ = appConfig.SetLoaders(
+		bconf.NewJSONFileLoaderWithAttributes(json.Unmarshal, "./fixtures/json_config_test_fixture_01.json"),
+		&bconf.EnvironmentLoader{KeyPrefix: "explain"},
+	)
+
+	errs := appConfig.AddFieldSet(bconf.FSB().Key("app").Fields(
+		bconf.FB().Key("id").Type(bconf.String).Sensitive().Create(),
+		bconf.FB().Key("name").Type(bconf.String).Default("default-name").Create(),
+		bconf.FB().Key("generated").Type(bconf.String).DefaultGenerator(func() (any, error) {
+			return "generated", nil
+		}).Create(),
+		bconf.FB().Key("unset").Type(bconf.String).Create(),
+		bconf.FB().Key("label").Type(bconf.String).Aliases("old_label").Create(),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-set: %v", errs)
+	}
+
+	t.Setenv("EXPLAIN_APP_ID", "environment-app-id")
+	t.Setenv("EXPLAIN_APP_OLD_LABEL", "label")
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	explanation, err := appConfig.Explain("app", "id")
+	if err != nil {
+		t.Fatalf("unexpected error explaining field: %s", err)
+	}
+
+	if explanation.Source != "bconf_environment" || explanation.SourceKey != "EXPLAIN_APP_ID" {
+		t.Errorf("unexpected explanation source '%s' (%s)", explanation.Source, explanation.SourceKey)
+	}
+
+	if len(explanation.Candidates) != 2 {
+		t.Fatalf("unexpected explanation candidates: %v", explanation.Candidates)
+	}
+
+	if explanation.Candidates[1].Loader != "bconf_jsonfile" || explanation.Candidates[1].SourceKey != "app.id" {
+		t.Errorf("unexpected lower precedence candidate: %+v", explanation.Candidates[1])
+	}
+
+	if strings.Contains(explanation.String(), "app-id") {
+		t.Errorf("unexpected sensitive value in explanation string: %s", explanation)
+	}
+
+	expectedSources := map[string]string{
+		"name":      bconf.ValueSourceDefault,
+		"generated": bconf.ValueSourceGeneratedDefault,
+		"unset":     "",
+	}
+
+	for fieldKey, expectedSource := range expectedSources {
+		if explanation, _ := appConfig.Explain("app", fieldKey); explanation.Source != expectedSource {
+			t.Errorf("unexpected '%s' source '%s', expected '%s'", fieldKey, explanation.Source, expectedSource)
+		}
+	}
+
+	if explanation, _ := appConfig.Explain("app", "label"); explanation.SourceKey != "EXPLAIN_APP_OLD_LABEL" {
+		t.Errorf("unexpected alias source key '%s'", explanation.SourceKey)
+	}
+
+	_ = appConfig.SetField("app", "name", "override-name")
+
+	explanation, _ = appConfig.Explain("app", "name")
+	if explanation.Source != bconf.ValueSourceOverride || explanation.Value != "override-name" {
+		t.Errorf("unexpected override explanation: %s", explanation)
+	}
+
+	if _, err := appConfig.Explain("app", "missing"); err == nil {
+		t.Errorf("expected error explaining missing field")
+	}
+}
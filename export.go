@@ -0,0 +1,256 @@
+package bconf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// ExportFormatJSON exports values in the shape read by JSONFileLoader
+	ExportFormatJSON = "json"
+	// ExportFormatYAML exports values as YAML, with field-sets as top-level keys
+	ExportFormatYAML = "yaml"
+	// ExportFormatEnv exports values as '.env' file lines matching EnvironmentLoader keys
+	ExportFormatEnv = "env"
+	// ExportFormatEnvExport exports values as shell 'export' statements matching EnvironmentLoader keys
+	ExportFormatEnvExport = "export"
+	// ExportFormatFlags exports values as flag arguments read by FlagLoader
+	ExportFormatFlags = "flags"
+)
+
+// ExportOptions defines which values are included by AppConfig.Export.
+type ExportOptions struct {
+	// IncludeSensitive includes values from Sensitive fields, which are otherwise omitted
+	IncludeSensitive bool
+	// IncludeDefaults includes values from defaults, rather than only values from loaders and overrides
+	IncludeDefaults bool
+}
+
+type exportValue struct {
+	value       any
+	field       *Field
+	fieldSetKey string
+}
+
+// Export serializes the effective configuration in a form that can be loaded again: JSON for JSONFileLoader,
+// YAML, '.env' lines or 'export' statements for EnvironmentLoader, or flag arguments for FlagLoader. Key prefixes are
+// taken from the app-config loaders when present. String slice values with elements containing a comma cannot be
+// loaded again, as loaders read commas as element separators, and are returned as an error.
+func (c *AppConfig) Export(format string, options ExportOptions) ([]byte, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	values, err := c.exportValues(options)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case ExportFormatJSON:
+		return c.exportJSON(values)
+	case ExportFormatYAML:
+		return exportYAML(values)
+	case ExportFormatEnv, ExportFormatEnvExport:
+		return c.exportEnv(values, format == ExportFormatEnvExport), nil
+	case ExportFormatFlags:
+		return c.exportFlags(values), nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: '%s'", format)
+	}
+}
+
+// -- Private methods --
+
+// exportValues returns the values to export, in the order field-sets and fields were added.
+func (c *AppConfig) exportValues(options ExportOptions) ([]exportValue, error) {
+	values := []exportValue{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		for _, field := range fieldSet.orderedFields() {
+			value, err := field.getValue()
+			if err != nil {
+				continue
+			}
+
+			switch field.getValueSource() {
+			case ValueSourceDefault, ValueSourceProfileDefault, ValueSourceGeneratedDefault:
+				if !options.IncludeDefaults {
+					continue
+				}
+			}
+
+			if field.Sensitive && !options.IncludeSensitive {
+				continue
+			}
+
+			if stringValues, ok := value.([]string); ok {
+				for _, stringValue := range stringValues {
+					if strings.Contains(stringValue, ",") {
+						return nil, fmt.Errorf(
+							"field '%s_%s' cannot be exported, value '%s' contains a comma",
+							fieldSet.Key,
+							field.Key,
+							stringValue,
+						)
+					}
+				}
+			}
+
+			values = append(values, exportValue{fieldSetKey: fieldSet.Key, field: field, value: value})
+		}
+	}
+
+	return values, nil
+}
+
+func (c *AppConfig) exportJSON(values []exportValue) ([]byte, error) {
+	encoder := func(v interface{}) ([]byte, error) {
+		return json.MarshalIndent(v, "", "  ")
+	}
+
+	for _, loader := range c.loaders {
+		if jsonFileLoader, ok := loader.(*JSONFileLoader); ok && jsonFileLoader.Encoder != nil {
+			encoder = jsonFileLoader.Encoder
+		}
+	}
+
+	fieldSets := map[string]map[string]any{}
+
+	for _, value := range values {
+		if _, found := fieldSets[value.fieldSetKey]; !found {
+			fieldSets[value.fieldSetKey] = map[string]any{}
+		}
+
+		fieldSets[value.fieldSetKey][value.field.Key] = encodableValue(value.value)
+	}
+
+	encoded, err := encoder(fieldSets)
+	if err != nil {
+		return nil, fmt.Errorf("problem encoding json: %w", err)
+	}
+
+	return encoded, nil
+}
+
+// exportYAML writes field-sets as YAML mappings, with values written as JSON, which is valid YAML flow syntax.
+func exportYAML(values []exportValue) ([]byte, error) {
+	builder := strings.Builder{}
+	fieldSetKey := ""
+
+	for _, value := range values {
+		if value.fieldSetKey != fieldSetKey {
+			fieldSetKey = value.fieldSetKey
+			builder.WriteString(fmt.Sprintf("%s:\n", fieldSetKey))
+		}
+
+		encoded, err := json.Marshal(encodableValue(value.value))
+		if err != nil {
+			return nil, fmt.Errorf("problem encoding field '%s_%s': %w", value.fieldSetKey, value.field.Key, err)
+		}
+
+		builder.WriteString(fmt.Sprintf("  %s: %s\n", value.field.Key, encoded))
+	}
+
+	return []byte(builder.String()), nil
+}
+
+func (c *AppConfig) exportEnv(values []exportValue, exportStatements bool) []byte {
+	loader := &EnvironmentLoader{}
+
+	for _, appConfigLoader := range c.loaders {
+		if environmentLoader, ok := appConfigLoader.(*EnvironmentLoader); ok {
+			loader = environmentLoader
+		}
+	}
+
+	builder := strings.Builder{}
+
+	for _, value := range values {
+		key := loader.SourceKey(value.fieldSetKey, value.field.Key)
+		formattedValue := value.field.formatValue(value.value)
+
+		if exportStatements {
+			builder.WriteString(fmt.Sprintf("export %s=%s\n", key, shellQuote(formattedValue)))
+		} else {
+			builder.WriteString(fmt.Sprintf("%s=%s\n", key, envQuote(formattedValue)))
+		}
+	}
+
+	return []byte(builder.String())
+}
+
+func (c *AppConfig) exportFlags(values []exportValue) []byte {
+	loader := &FlagLoader{}
+
+	for _, appConfigLoader := range c.loaders {
+		if flagLoader, ok := appConfigLoader.(*FlagLoader); ok {
+			loader = flagLoader
+		}
+	}
+
+	args := make([]string, len(values))
+
+	for index, value := range values {
+		key := loader.SourceKey(value.fieldSetKey, value.field.Key)
+		args[index] = fmt.Sprintf("--%s=%s", key, shellQuote(value.field.formatValue(value.value)))
+	}
+
+	if len(args) < 1 {
+		return []byte{}
+	}
+
+	return []byte(strings.Join(args, " ") + "\n")
+}
+
+// encodableValue returns a value that encodes to a form parsed back to the same field value, e.g. durations as '5s'.
+func encodableValue(value any) any {
+	switch typedValue := value.(type) {
+	case time.Duration:
+		return typedValue.String()
+	case []time.Duration:
+		durations := make([]string, len(typedValue))
+		for index, duration := range typedValue {
+			durations[index] = duration.String()
+		}
+
+		return durations
+	}
+
+	return value
+}
+
+// shellQuote returns the value quoted for use as a single shell word, when quoting is needed.
+func shellQuote(value string) string {
+	if value != "" && !needsQuotes(value) {
+		return value
+	}
+
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// envQuote returns the value quoted for use in a '.env' file, when quoting is needed.
+func envQuote(value string) string {
+	if !needsQuotes(value) {
+		return value
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`)
+
+	return `"` + replacer.Replace(value) + `"`
+}
+
+func needsQuotes(value string) bool {
+	for _, character := range value {
+		switch {
+		case character >= 'a' && character <= 'z', character >= 'A' && character <= 'Z':
+		case character >= '0' && character <= '9':
+		case strings.ContainsRune("_-.,:/+@%=", character):
+		default:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,217 @@
+package bconf_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigExport(t *testing.T) {
+	appConfig := createExportTestAppConfig(t, &bconf.EnvironmentLoader{KeyPrefix: "export"})
+
+	t.Setenv("EXPORT_EXPORT_HOSTS", "a.example.com,b.example.com")
+	t.Setenv("EXPORT_EXPORT_TIMEOUT", "1m30s")
+	t.Setenv("EXPORT_EXPORT_MESSAGE", "it's a value")
+	t.Setenv("EXPORT_EXPORT_TOKEN", "secret")
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	envExport, err := appConfig.Export(bconf.ExportFormatEnv, bconf.ExportOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error exporting env: %s", err)
+	}
+
+	expectedEnv := "EXPORT_EXPORT_HOSTS=a.example.com,b.example.com\n" +
+		"EXPORT_EXPORT_TIMEOUT=1m30s\n" +
+		"EXPORT_EXPORT_MESSAGE=\"it's a value\"\n"
+	if string(envExport) != expectedEnv {
+		t.Errorf("unexpected env export:\n%s", envExport)
+	}
+
+	shellExport, _ := appConfig.Export(bconf.ExportFormatEnvExport, bconf.ExportOptions{IncludeSensitive: true})
+	if !strings.Contains(string(shellExport), `export EXPORT_EXPORT_MESSAGE='it'\''s a value'`) ||
+		!strings.Contains(string(shellExport), "export EXPORT_EXPORT_TOKEN=secret") {
+		t.Errorf("unexpected shell export:\n%s", shellExport)
+	}
+
+	withDefaults, _ := appConfig.Export(bconf.ExportFormatEnv, bconf.ExportOptions{IncludeDefaults: true})
+	if !strings.Contains(string(withDefaults), "EXPORT_EXPORT_PORT=8080") {
+		t.Errorf("expected env export to include default values:\n%s", withDefaults)
+	}
+
+	yamlExport, _ := appConfig.Export(bconf.ExportFormatYAML, bconf.ExportOptions{})
+	if !strings.HasPrefix(string(yamlExport), "export:\n  hosts: [\"a.example.com\",\"b.example.com\"]\n") {
+		t.Errorf("unexpected yaml export:\n%s", yamlExport)
+	}
+
+	if _, err := appConfig.Export("xml", bconf.ExportOptions{}); err == nil {
+		t.Errorf("expected error exporting unsupported format")
+	}
+
+	options := bconf.ExportOptions{IncludeSensitive: true, IncludeDefaults: true}
+
+	jsonExport, err := appConfig.Export(bconf.ExportFormatJSON, options)
+	if err != nil {
+		t.Fatalf("unexpected error exporting json: %s", err)
+	}
+
+	jsonPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(jsonPath, jsonExport, 0o600); err != nil {
+		t.Fatalf("unexpected error writing json export: %s", err)
+	}
+
+	jsonAppConfig := createExportTestAppConfig(t, bconf.NewJSONFileLoaderWithAttributes(json.Unmarshal, jsonPath))
+	assertExportRoundTrip(t, appConfig, jsonAppConfig)
+
+	flagsExport, _ := appConfig.Export(bconf.ExportFormatFlags, bconf.ExportOptions{IncludeSensitive: true})
+	if !strings.Contains(string(flagsExport), "--export_timeout=1m30s") {
+		t.Errorf("unexpected flags export: %s", flagsExport)
+	}
+}
+
+func TestAppConfigExportRoundTrip(t *testing.T) {
+	appConfig := createExportTestAppConfig(t, &bconf.EnvironmentLoader{KeyPrefix: "export"})
+	_ = appConfig.SetLoaders(&bconf.EnvironmentLoader{KeyPrefix: "export"}, bconf.NewFlagLoaderWithKeyPrefix("ext"))
+
+	t.Setenv("EXPORT_EXPORT_HOSTS", "a.example.com,b.example.com")
+	t.Setenv("EXPORT_EXPORT_TIMEOUT", "1m30s")
+	t.Setenv("EXPORT_EXPORT_MESSAGE", "message")
+	t.Setenv("EXPORT_EXPORT_TOKEN", "secret")
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	jsonExport, err := appConfig.Export(bconf.ExportFormatJSON, bconf.ExportOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error exporting json: %s", err)
+	}
+
+	if strings.Contains(string(jsonExport), "token") {
+		t.Errorf("expected json export to omit sensitive values:\n%s", jsonExport)
+	}
+
+	jsonPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(jsonPath, jsonExport, 0o600); err != nil {
+		t.Fatalf("unexpected error writing json export: %s", err)
+	}
+
+	jsonAppConfig := createExportTestAppConfig(t, bconf.NewJSONFileLoaderWithAttributes(json.Unmarshal, jsonPath))
+	if errs := jsonAppConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config from json export: %v", errs)
+	}
+
+	if _, err := jsonAppConfig.GetString("export", "token"); err == nil {
+		t.Errorf("expected omitted sensitive value to be unset after round trip")
+	}
+
+	flagsExport, err := appConfig.Export(bconf.ExportFormatFlags, bconf.ExportOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error exporting flags: %s", err)
+	}
+
+	if !strings.Contains(string(flagsExport), "--ext_export_timeout=1m30s") {
+		t.Errorf("expected flags export to use the flag loader key prefix: %s", flagsExport)
+	}
+
+	flagLoader := &bconf.FlagLoader{KeyPrefix: "ext", OverrideLookup: strings.Fields(string(flagsExport))}
+	flagAppConfig := createExportTestAppConfig(t, flagLoader)
+
+	if errs := flagAppConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config from flags export: %v", errs)
+	}
+
+	for _, fieldKey := range []string{"hosts", "timeout", "message"} {
+		exportedExplanation, _ := appConfig.Explain("export", fieldKey)
+		jsonExplanation, _ := jsonAppConfig.Explain("export", fieldKey)
+		flagExplanation, _ := flagAppConfig.Explain("export", fieldKey)
+
+		if !reflect.DeepEqual(exportedExplanation.Value, jsonExplanation.Value) ||
+			!reflect.DeepEqual(exportedExplanation.Value, flagExplanation.Value) {
+			t.Errorf(
+				"unexpected round trip values for '%s': json '%v', flags '%v', expected '%v'",
+				fieldKey,
+				jsonExplanation.Value,
+				flagExplanation.Value,
+				exportedExplanation.Value,
+			)
+		}
+	}
+
+	flagExplanation, _ := flagAppConfig.Explain("export", "timeout")
+	if flagExplanation.SourceKey != "ext_export_timeout" {
+		t.Errorf("unexpected flag source key: '%s'", flagExplanation.SourceKey)
+	}
+}
+
+func TestAppConfigExportStringsWithCommas(t *testing.T) {
+	appConfig := bconf.NewAppConfig("app", "description")
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("export").Fields(
+		bconf.FB().Key("names").Type(bconf.Strings).Default([]string{"last, first"}).Create(),
+	).Create())
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	_, err := appConfig.Export(bconf.ExportFormatEnv, bconf.ExportOptions{IncludeDefaults: true})
+	if err == nil || !strings.Contains(err.Error(), "field 'export_names' cannot be exported") {
+		t.Errorf("expected error exporting string slice value containing a comma, got: %v", err)
+	}
+}
+
+func createExportTestAppConfig(t *testing.T, loader bconf.Loader) *bconf.AppConfig {
+	t.Helper()
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.SetLoaders(loader)
+
+	errs := appConfig.AddFieldSet(bconf.FSB().Key("export").Fields(
+		bconf.FB().Key("hosts").Type(bconf.Strings).Create(),
+		bconf.FB().Key("timeout").Type(bconf.Duration).Create(),
+		bconf.FB().Key("message").Type(bconf.String).Create(),
+		bconf.FB().Key("token").Type(bconf.String).Sensitive().Create(),
+		bconf.FB().Key("port").Type(bconf.Int).Default(8080).Create(),
+		bconf.FB().Key("started").Type(bconf.Time).Default(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)).Create(),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-set: %v", errs)
+	}
+
+	return appConfig
+}
+
+func assertExportRoundTrip(t *testing.T, exported, loaded *bconf.AppConfig) {
+	t.Helper()
+
+	if errs := loaded.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config from export: %v", errs)
+	}
+
+	for _, fieldKey := range []string{"hosts", "timeout", "message", "token", "port", "started"} {
+		exportedField, _ := exported.GetField("export", fieldKey)
+		loadedField, _ := loaded.GetField("export", fieldKey)
+
+		exportedExplanation, _ := exported.Explain("export", fieldKey)
+		loadedExplanation, _ := loaded.Explain("export", fieldKey)
+
+		if exportedField.Type != loadedField.Type ||
+			!reflect.DeepEqual(exportedExplanation.Value, loadedExplanation.Value) {
+			t.Errorf(
+				"unexpected round trip value for '%s': '%v', expected '%v'",
+				fieldKey,
+				loadedExplanation.Value,
+				exportedExplanation.Value,
+			)
+		}
+	}
+}
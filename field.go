@@ -3,6 +3,7 @@ package bconf
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -12,6 +13,12 @@ import (
 
 const emptyFieldError = "empty field value"
 
+// sensitiveValuePlaceholder replaces the values of Sensitive fields wherever values are displayed
+const sensitiveValuePlaceholder = "<sensitive-value>"
+
+// generatedDefaultPlaceholder describes default values created by a DefaultGenerator in help and documentation
+const generatedDefaultPlaceholder = "<generated-at-run-time>"
+
 // Fields is a slice of Field elements providing context for configuration values
 type Fields []*Field
 
@@ -19,12 +26,17 @@ type Fields []*Field
 type Field struct {
 	// fieldValue contains a mapping of loader names to field value
 	fieldValue map[string]any
+	// fieldSourceKey contains a mapping of loader names to the source key the field value was found with
+	fieldSourceKey map[string]string
 	// Validator defines a function that runs during validation to check a value against validity constraints
 	Validator func(value any) error
 	// DefaultGenerator defines a function that creates a base value for a field
 	DefaultGenerator func() (any, error)
 	// Default defines a base value for a field
 	Default any
+	// ProfileDefaults defines base values for a field by profile name, taking precedence over Default when the profile
+	// is active
+	ProfileDefaults map[string]any
 	// generatedDefault tracks the value generated from the default generator function
 	generatedDefault any
 	// overrideValue tracks a user set field value
@@ -39,12 +51,23 @@ type Field struct {
 	Enumeration []any
 	// LoadConditions defines the conditions required for a field to load values
 	LoadConditions LoadConditions
+	// Aliases defines alternative keys the field value is loaded from when the field key is not found, either a key in
+	// the same field-set ('old_key') or a key in another field-set ('other_field_set.old_key')
+	Aliases []string
+	// DeprecationMessage defines guidance included in warnings when a deprecated field is used
+	DeprecationMessage string
+	// RequiredInProfiles defines the profiles in which a field value must be set in order for the field to be valid
+	RequiredInProfiles []string
+	// profile tracks the active AppConfig profile
+	profile string
 	// fieldFound is a reverse priority list of where field values were found, e.g. last value has highest priority
 	fieldFound []string
 	// Required defines whether a field value must be set in order for the field to be valid
 	Required bool
 	// Sensitive identifies the field value as sensitive
 	Sensitive bool
+	// Deprecated identifies the field as deprecated, emitting a warning when a loader finds a value for the field
+	Deprecated bool
 }
 
 func (f *Field) Clone() *Field {
@@ -56,6 +79,24 @@ func (f *Field) Clone() *Field {
 	clone.Enumeration = make([]any, len(f.Enumeration))
 	copy(clone.Enumeration, f.Enumeration)
 
+	if len(f.Aliases) > 0 {
+		clone.Aliases = make([]string, len(f.Aliases))
+		copy(clone.Aliases, f.Aliases)
+	}
+
+	if len(f.RequiredInProfiles) > 0 {
+		clone.RequiredInProfiles = make([]string, len(f.RequiredInProfiles))
+		copy(clone.RequiredInProfiles, f.RequiredInProfiles)
+	}
+
+	if len(f.ProfileDefaults) > 0 {
+		clone.ProfileDefaults = make(map[string]any, len(f.ProfileDefaults))
+
+		for profile, value := range f.ProfileDefaults {
+			clone.ProfileDefaults[profile] = value
+		}
+	}
+
 	if len(f.fieldValue) > 0 {
 		clone.fieldValue = make(map[string]any, len(f.fieldValue))
 
@@ -64,6 +105,14 @@ func (f *Field) Clone() *Field {
 		}
 	}
 
+	if len(f.fieldSourceKey) > 0 {
+		clone.fieldSourceKey = make(map[string]string, len(f.fieldSourceKey))
+
+		for key, value := range f.fieldSourceKey {
+			clone.fieldSourceKey[key] = value
+		}
+	}
+
 	if len(f.LoadConditions) > 0 {
 		clone.LoadConditions = make(LoadConditions, len(f.LoadConditions))
 
@@ -126,10 +175,18 @@ func (f *Field) validate() []error {
 			errs = append(errs, err)
 		}
 
+		if validationErrs := f.validateProfileDefaultsFieldType(fieldType); len(validationErrs) > 0 {
+			errs = append(errs, validationErrs...)
+		}
+
 		if validationErrs := f.validateEnumerationValuesFieldType(fieldType); len(validationErrs) > 0 {
 			errs = append(errs, validationErrs...)
 		}
 
+		if validationErrs := f.validateAliases(); len(validationErrs) > 0 {
+			errs = append(errs, validationErrs...)
+		}
+
 		// Return here before validating default values existing in enumeration list
 		if len(errs) > 0 {
 			return errs
@@ -142,6 +199,10 @@ func (f *Field) validate() []error {
 		if err := f.validateDefaultValuesPassValidatorFunc(); err != nil {
 			errs = append(errs, err)
 		}
+
+		if validationErrs := f.validateProfileDefaultValues(); len(validationErrs) > 0 {
+			errs = append(errs, validationErrs...)
+		}
 	}
 
 	if !fieldTypeFound {
@@ -162,6 +223,16 @@ func (f *Field) validateNoConflictingParams() []error {
 		errs = append(errs, fmt.Errorf(bconfconst.ErrorFieldRequiredWithDefault))
 	}
 
+	if f.Required && len(f.ProfileDefaults) > 0 {
+		errs = append(errs, fmt.Errorf(bconfconst.ErrorFieldRequiredWithProfileDefault))
+	}
+
+	for _, profile := range f.RequiredInProfiles {
+		if _, found := f.ProfileDefaults[profile]; found {
+			errs = append(errs, fmt.Errorf("%s: '%s'", bconfconst.ErrorFieldRequiredInProfileWithDefault, profile))
+		}
+	}
+
 	return errs
 }
 
@@ -197,6 +268,87 @@ func (f *Field) validateGeneratedDefaultFieldType(fieldType string) error {
 	)
 }
 
+func (f *Field) validateProfileDefaultsFieldType(fieldType string) []error {
+	errs := []error{}
+
+	for _, profile := range f.profileDefaultNames() {
+		value := f.ProfileDefaults[profile]
+		if value != nil && reflect.TypeOf(value).String() == fieldType {
+			continue
+		}
+
+		errs = append(
+			errs,
+			fmt.Errorf(
+				"invalid profile '%s' default type: expected '%s', found '%s'",
+				profile,
+				fieldType,
+				reflect.TypeOf(value),
+			),
+		)
+	}
+
+	return errs
+}
+
+func (f *Field) validateProfileDefaultValues() []error {
+	errs := []error{}
+
+	for _, profile := range f.profileDefaultNames() {
+		value := f.ProfileDefaults[profile]
+
+		if !f.valueInEnumeration(value) {
+			errs = append(errs, fmt.Errorf(
+				"invalid profile '%s' default value: default value '%v' expected in enumeration list",
+				profile,
+				value,
+			))
+
+			continue
+		}
+
+		if f.Validator != nil {
+			if err := f.Validator(value); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"invalid profile '%s' default value: error from field validator: %w",
+					profile,
+					err,
+				))
+			}
+		}
+	}
+
+	return errs
+}
+
+// profileDefaultNames returns the names of profiles with default values, sorted alphabetically.
+func (f *Field) profileDefaultNames() []string {
+	profiles := make([]string, 0, len(f.ProfileDefaults))
+
+	for profile := range f.ProfileDefaults {
+		profiles = append(profiles, profile)
+	}
+
+	sort.Strings(profiles)
+
+	return profiles
+}
+
+// requiredInProfile returns whether the field value must be set, given the active profile.
+func (f *Field) requiredInProfile() bool {
+	if f.Required {
+		return true
+	}
+
+	for _, profile := range f.RequiredInProfiles {
+		if profile == f.profile {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (f *Field) validateEnumerationValuesFieldType(fieldType string) []error {
 	if f.Enumeration == nil || len(f.Enumeration) < 1 {
 		return nil
@@ -222,6 +374,40 @@ func (f *Field) validateEnumerationValuesFieldType(fieldType string) []error {
 	return errs
 }
 
+func (f *Field) validateAliases() []error {
+	errs := []error{}
+	aliases := map[string]struct{}{}
+
+	for _, alias := range f.Aliases {
+		aliasLocation := strings.Split(alias, ".")
+
+		switch {
+		case alias == "" || len(aliasLocation) > 2 || aliasLocation[0] == "" || aliasLocation[len(aliasLocation)-1] == "":
+			errs = append(errs, fmt.Errorf("invalid alias '%s': expected '<field>' or '<field-set>.<field>'", alias))
+		case alias == f.Key:
+			errs = append(errs, fmt.Errorf("invalid alias '%s': cannot match field key", alias))
+		default:
+			if _, found := aliases[alias]; found {
+				errs = append(errs, fmt.Errorf("duplicate alias found: '%s'", alias))
+			}
+		}
+
+		aliases[alias] = struct{}{}
+	}
+
+	return errs
+}
+
+// aliasLocation returns the field-set key and field key an alias refers to, with aliases lacking a field-set key
+// referring to the field's own field-set.
+func (f *Field) aliasLocation(alias, fieldSetKey string) (aliasFieldSetKey, aliasFieldKey string) {
+	if splitIndex := strings.Index(alias, "."); splitIndex > -1 {
+		return alias[:splitIndex], alias[splitIndex+1:]
+	}
+
+	return fieldSetKey, alias
+}
+
 func (f *Field) validateDefaultValuesInEnumeration() error {
 	if f.Default != nil && !f.valueInEnumeration(f.Default) {
 		return fmt.Errorf(
@@ -273,6 +459,10 @@ func (f *Field) getValue() (any, error) {
 		return value, nil
 	}
 
+	if value, found := f.ProfileDefaults[f.profile]; found && f.profile != "" {
+		return value, nil
+	}
+
 	if f.Default != nil {
 		return f.Default, nil
 	}
@@ -284,18 +474,62 @@ func (f *Field) getValue() (any, error) {
 	return nil, fmt.Errorf(emptyFieldError)
 }
 
-// func (f *Field) getValueFrom(loader string) (any, error) {
-// 	if f.fieldValue == nil {
-// 		return nil, fmt.Errorf("")
-// 	}
+func (f *Field) getValueFrom(loader string) (any, error) {
+	if f.fieldValue == nil {
+		return nil, fmt.Errorf(emptyFieldError)
+	}
+
+	value, found := f.fieldValue[loader]
+	if !found {
+		return nil, fmt.Errorf("no value found by loader '%s'", loader)
+	}
 
-// 	value, found := f.fieldValue[loader]
-// 	if !found {
-// 		return nil, fmt.Errorf("")
-// 	}
+	return value, nil
+}
 
-// 	return value, nil
-// }
+// getDefaultValue returns the default value getValue falls back to when no override or loader value is set.
+func (f *Field) getDefaultValue() (any, bool) {
+	if value, found := f.ProfileDefaults[f.profile]; found && f.profile != "" {
+		return value, true
+	}
+
+	if f.Default != nil {
+		return f.Default, true
+	}
+
+	if f.generatedDefault != nil {
+		return f.generatedDefault, true
+	}
+
+	return nil, false
+}
+
+// getValueSource returns where the field value returned by getValue comes from: an override, the name of a loader, a
+// default, or an empty string when no value is set.
+func (f *Field) getValueSource() string {
+	switch _, profileDefaultFound := f.ProfileDefaults[f.profile]; {
+	case f.overrideValue != nil:
+		return ValueSourceOverride
+	case len(f.fieldFound) > 0:
+		return f.fieldFound[len(f.fieldFound)-1]
+	case profileDefaultFound && f.profile != "":
+		return ValueSourceProfileDefault
+	case f.Default != nil:
+		return ValueSourceDefault
+	case f.generatedDefault != nil:
+		return ValueSourceGeneratedDefault
+	}
+
+	return ""
+}
+
+func (f *Field) setSourceKey(loaderName, sourceKey string) {
+	if f.fieldSourceKey == nil {
+		f.fieldSourceKey = map[string]string{}
+	}
+
+	f.fieldSourceKey[loaderName] = sourceKey
+}
 
 func (f *Field) set(loaderName, value string) error {
 	parsedValue, err := f.parseString(value)
@@ -316,7 +550,7 @@ func (f *Field) set(loaderName, value string) error {
 	if f.fieldValue == nil {
 		f.fieldValue = map[string]any{loaderName: parsedValue}
 	} else {
-		f.fieldValue[loaderName] = value
+		f.fieldValue[loaderName] = parsedValue
 	}
 
 	if f.fieldFound == nil {
@@ -328,6 +562,13 @@ func (f *Field) set(loaderName, value string) error {
 	return nil
 }
 
+// clearLoaderValues removes values set by loaders, e.g. before a field is reloaded.
+func (f *Field) clearLoaderValues() {
+	f.fieldValue = nil
+	f.fieldFound = nil
+	f.fieldSourceKey = nil
+}
+
 func (f *Field) setOverride(value any) error {
 	if reflect.TypeOf(value).String() != f.Type {
 		return fmt.Errorf(
@@ -366,6 +607,10 @@ func (f *Field) parseString(value string) (any, error) {
 		return strconv.Atoi(value)
 	case Ints:
 		return f.parseToInts(value)
+	case Float:
+		return strconv.ParseFloat(value, 64)
+	case Floats:
+		return f.parseToFloats(value)
 	case Time:
 		return time.Parse(time.RFC3339, value)
 	case Times:
@@ -379,6 +624,88 @@ func (f *Field) parseString(value string) (any, error) {
 	}
 }
 
+// formatValue formats a field value as a string that parseString parses back to the same value.
+func (f *Field) formatValue(value any) string {
+	switch typedValue := value.(type) {
+	case time.Time:
+		return typedValue.Format(time.RFC3339Nano)
+	case []string:
+		return strings.Join(typedValue, ",")
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() != reflect.Slice {
+		return fmt.Sprintf("%v", value)
+	}
+
+	elements := make([]string, reflectValue.Len())
+	for index := range elements {
+		elements[index] = f.formatValue(reflectValue.Index(index).Interface())
+	}
+
+	return strings.Join(elements, ",")
+}
+
+// fieldDefault describes a field default value for help and documentation, with an empty profile for the Default.
+type fieldDefault struct {
+	profile   string
+	value     string
+	generated bool
+}
+
+// line returns the default value as a documentation line, e.g. "Default: 'info'" or "Default (production): 'warn'".
+func (d fieldDefault) line() string {
+	switch {
+	case d.generated:
+		return fmt.Sprintf("Default: %s", d.value)
+	case d.profile != "":
+		return fmt.Sprintf("Default (%s): '%s'", d.profile, d.value)
+	default:
+		return fmt.Sprintf("Default: '%s'", d.value)
+	}
+}
+
+// displayValue formats a value for help and documentation, replacing values of Sensitive fields.
+func (f *Field) displayValue(value any) string {
+	if f.Sensitive {
+		return sensitiveValuePlaceholder
+	}
+
+	return f.formatValue(value)
+}
+
+// displayDefaults returns the Default or generated default of a field, followed by its profile defaults in profile
+// name order.
+func (f *Field) displayDefaults() []fieldDefault {
+	defaults := []fieldDefault{}
+
+	switch {
+	case f.Default != nil:
+		defaults = append(defaults, fieldDefault{value: f.displayValue(f.Default)})
+	case f.DefaultGenerator != nil:
+		defaults = append(defaults, fieldDefault{value: generatedDefaultPlaceholder, generated: true})
+	}
+
+	for _, profile := range f.profileDefaultNames() {
+		defaults = append(defaults, fieldDefault{profile: profile, value: f.displayValue(f.ProfileDefaults[profile])})
+	}
+
+	return defaults
+}
+
+// deprecationNote returns "Deprecated", followed by the DeprecationMessage when set, or an empty string for fields
+// that are not deprecated.
+func (f *Field) deprecationNote() string {
+	switch {
+	case f.Deprecated && f.DeprecationMessage != "":
+		return fmt.Sprintf("Deprecated: %s", f.DeprecationMessage)
+	case f.Deprecated:
+		return "Deprecated"
+	default:
+		return ""
+	}
+}
+
 func (f *Field) parseToStrings(value string) []string {
 	if value == "" {
 		return []string{}
@@ -427,6 +754,22 @@ func (f *Field) parseToInts(value string) ([]int, error) {
 	return values, nil
 }
 
+func (f *Field) parseToFloats(value string) ([]float64, error) {
+	list := strings.Split(value, ",")
+	values := make([]float64, len(list))
+
+	for idx, elem := range list {
+		parsedValue, err := strconv.ParseFloat(strings.Trim(elem, " "), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		values[idx] = parsedValue
+	}
+
+	return values, nil
+}
+
 func (f *Field) parseToTimes(value string) ([]time.Time, error) {
 	list := strings.Split(value, ",")
 	values := make([]time.Time, len(list))
@@ -484,7 +827,7 @@ func (f *Field) enumerationString() string {
 				builder.WriteString(", ")
 			}
 
-			builder.WriteString(fmt.Sprintf("'%s'", value))
+			builder.WriteString(fmt.Sprintf("'%v'", value))
 		}
 
 		builder.WriteString("]")
@@ -82,6 +82,46 @@ func (b *FieldBuilder) Sensitive() *FieldBuilder {
 	return b
 }
 
+func (b *FieldBuilder) Aliases(value ...string) *FieldBuilder {
+	b.init()
+	b.field.Aliases = value
+
+	return b
+}
+
+func (b *FieldBuilder) Deprecated() *FieldBuilder {
+	b.init()
+	b.field.Deprecated = true
+
+	return b
+}
+
+func (b *FieldBuilder) DeprecationMessage(value string) *FieldBuilder {
+	b.init()
+	b.field.DeprecationMessage = value
+
+	return b
+}
+
+func (b *FieldBuilder) ProfileDefault(profile string, value any) *FieldBuilder {
+	b.init()
+
+	if b.field.ProfileDefaults == nil {
+		b.field.ProfileDefaults = map[string]any{}
+	}
+
+	b.field.ProfileDefaults[profile] = value
+
+	return b
+}
+
+func (b *FieldBuilder) RequiredInProfiles(value ...string) *FieldBuilder {
+	b.init()
+	b.field.RequiredInProfiles = value
+
+	return b
+}
+
 func (b *FieldBuilder) Create() *Field {
 	b.init()
 	return b.field.Clone()
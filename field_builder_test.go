@@ -111,3 +111,37 @@ func TestFieldBuilderSensitive(t *testing.T) {
 		t.Fatalf("expected field to be sensitive")
 	}
 }
+
+func TestFieldBuilderAliases(t *testing.T) {
+	fieldAliases := []string{"old_key", "other_field_set.old_key"}
+
+	field := bconf.FB().Aliases(fieldAliases...).Create()
+	if len(field.Aliases) != len(fieldAliases) {
+		t.Fatalf("unexpected field aliases length '%d', expected '%d'", len(field.Aliases), len(fieldAliases))
+	}
+}
+
+func TestFieldBuilderDeprecated(t *testing.T) {
+	const deprecationMessage = "use 'new_key' instead"
+
+	field := bconf.FB().Deprecated().DeprecationMessage(deprecationMessage).Create()
+	if field.Deprecated == false {
+		t.Fatalf("expected field to be deprecated")
+	}
+
+	if field.DeprecationMessage != deprecationMessage {
+		t.Fatalf("unexpected deprecation message '%s', expected '%s'", field.DeprecationMessage, deprecationMessage)
+	}
+}
+
+func TestFieldBuilderProfiles(t *testing.T) {
+	field := bconf.FB().ProfileDefault("dev", "debug").ProfileDefault("prod", "warn").RequiredInProfiles("prod").Create()
+
+	if len(field.ProfileDefaults) != 2 || field.ProfileDefaults["dev"] != "debug" {
+		t.Fatalf("unexpected field profile defaults: %v", field.ProfileDefaults)
+	}
+
+	if len(field.RequiredInProfiles) != 1 || field.RequiredInProfiles[0] != "prod" {
+		t.Fatalf("unexpected field required-in-profiles: %v", field.RequiredInProfiles)
+	}
+}
@@ -0,0 +1,184 @@
+package bconf
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FieldChange describes a change to a field value, with values from Sensitive fields redacted when formatted.
+type FieldChange struct {
+	OldValue    any
+	NewValue    any
+	FieldSetKey string
+	FieldKey    string
+	Sensitive   bool
+}
+
+func (c FieldChange) String() string {
+	oldValue, newValue := c.OldValue, c.NewValue
+
+	if c.Sensitive {
+		oldValue, newValue = sensitiveValuePlaceholder, sensitiveValuePlaceholder
+	}
+
+	return fmt.Sprintf("field '%s_%s' changed from '%v' to '%v'", c.FieldSetKey, c.FieldKey, oldValue, newValue)
+}
+
+// OnChange registers a handler that is called with the old and new field value whenever the value changes after a
+// reload (LoadFieldSet, LoadField, or Watch), or an override set with SetField. Values from Sensitive fields are passed
+// to the handler as-is, so handlers should avoid logging them.
+func (c *AppConfig) OnChange(fieldSetKey, fieldKey string, handler func(oldValue, newValue any)) error {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	fieldSet, fieldSetFound := c.fieldSets[fieldSetKey]
+	if !fieldSetFound {
+		return fmt.Errorf("field-set with key '%s' not found", fieldSetKey)
+	}
+
+	if _, fieldKeyFound := fieldSet.fieldMap[fieldKey]; !fieldKeyFound {
+		return fmt.Errorf("field with key '%s' not found", fieldKey)
+	}
+
+	if c.fieldChangeHandlers == nil {
+		c.fieldChangeHandlers = map[string][]func(oldValue, newValue any){}
+	}
+
+	key := fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)
+	c.fieldChangeHandlers[key] = append(c.fieldChangeHandlers[key], handler)
+
+	return nil
+}
+
+// OnFieldSetChange registers a handler that is called with every changed field value in a field-set whenever values
+// change after a reload (LoadFieldSet, LoadField, or Watch), or an override set with SetField.
+func (c *AppConfig) OnFieldSetChange(fieldSetKey string, handler func(changes []FieldChange)) error {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	if _, fieldSetFound := c.fieldSets[fieldSetKey]; !fieldSetFound {
+		return fmt.Errorf("field-set with key '%s' not found", fieldSetKey)
+	}
+
+	if c.fieldSetChangeHandlers == nil {
+		c.fieldSetChangeHandlers = map[string][]func(changes []FieldChange){}
+	}
+
+	c.fieldSetChangeHandlers[fieldSetKey] = append(c.fieldSetChangeHandlers[fieldSetKey], handler)
+
+	return nil
+}
+
+// updateFields runs an update of field values while holding the field-set lock, and replaces the current snapshot.
+// Warnings, and change handlers for every changed field value, are called once the lock has been released, so
+// handlers can safely call AppConfig methods.
+func (c *AppConfig) updateFields(update func() []error) []error {
+	errs, changes := func() ([]error, []FieldChange) {
+		c.fieldSetLock.Lock()
+		defer c.fieldSetLock.Unlock()
+
+		previousValues := c.fieldValues()
+		errs := update()
+		c.generation++
+
+		if c.registered {
+			c.refreshCurrentSnapshot()
+		}
+
+		return errs, c.fieldChanges(previousValues)
+	}()
+
+	c.notifyWarnings()
+	c.notifyFieldChanges(changes)
+
+	return errs
+}
+
+// fieldValues returns the current value of every field by field-set key and field key, with unset fields mapped to
+// nil.
+func (c *AppConfig) fieldValues() map[string]map[string]any {
+	values := make(map[string]map[string]any, len(c.fieldSets))
+
+	for fieldSetKey, fieldSet := range c.fieldSets {
+		fieldSetValues := make(map[string]any, len(fieldSet.fieldMap))
+
+		for fieldKey, field := range fieldSet.fieldMap {
+			value, _ := field.getValue()
+			fieldSetValues[fieldKey] = value
+		}
+
+		values[fieldSetKey] = fieldSetValues
+	}
+
+	return values
+}
+
+// fieldChanges returns the fields with values that differ from the previous values, in field-set and field order.
+func (c *AppConfig) fieldChanges(previousValues map[string]map[string]any) []FieldChange {
+	changes := []FieldChange{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		for _, field := range fieldSet.orderedFields() {
+			oldValue := previousValues[fieldSet.Key][field.Key]
+			newValue, _ := field.getValue()
+
+			if reflect.DeepEqual(oldValue, newValue) {
+				continue
+			}
+
+			changes = append(changes, FieldChange{
+				FieldSetKey: fieldSet.Key,
+				FieldKey:    field.Key,
+				OldValue:    oldValue,
+				NewValue:    newValue,
+				Sensitive:   field.Sensitive,
+			})
+		}
+	}
+
+	return changes
+}
+
+// notifyFieldChanges calls the field handlers for every change, followed by the field-set handlers for every field-set
+// with changes. The caller must not hold the field-set lock.
+func (c *AppConfig) notifyFieldChanges(changes []FieldChange) {
+	if len(changes) < 1 {
+		return
+	}
+
+	notifications := []func(){}
+	fieldSetChanges := map[string][]FieldChange{}
+	fieldSetKeys := []string{}
+
+	c.fieldSetLock.RLock()
+
+	for _, change := range changes {
+		change := change
+
+		for _, handler := range c.fieldChangeHandlers[fmt.Sprintf("%s_%s", change.FieldSetKey, change.FieldKey)] {
+			handler := handler
+			notifications = append(notifications, func() { handler(change.OldValue, change.NewValue) })
+		}
+
+		if _, found := fieldSetChanges[change.FieldSetKey]; !found {
+			fieldSetKeys = append(fieldSetKeys, change.FieldSetKey)
+		}
+
+		fieldSetChanges[change.FieldSetKey] = append(fieldSetChanges[change.FieldSetKey], change)
+	}
+
+	for _, fieldSetKey := range fieldSetKeys {
+		changes := fieldSetChanges[fieldSetKey]
+
+		for _, handler := range c.fieldSetChangeHandlers[fieldSetKey] {
+			handler := handler
+			notifications = append(notifications, func() { handler(changes) })
+		}
+	}
+
+	c.fieldSetLock.RUnlock()
+
+	for _, notify := range notifications {
+		notify()
+	}
+}
@@ -0,0 +1,86 @@
+package bconf_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestFieldChangeString(t *testing.T) {
+	change := bconf.FieldChange{FieldSetKey: "log", FieldKey: "level", OldValue: "info", NewValue: "debug"}
+	if change.String() != "field 'log_level' changed from 'info' to 'debug'" {
+		t.Errorf("unexpected field change string: '%s'", change)
+	}
+
+	change = bconf.FieldChange{
+		FieldSetKey: "api", FieldKey: "token", OldValue: "secret-a", NewValue: "secret-b", Sensitive: true,
+	}
+	if strings.Contains(change.String(), "secret") {
+		t.Errorf("unexpected sensitive value in field change string: '%s'", change)
+	}
+}
+
+func TestAppConfigOnChange(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("change_log").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Default("info").Create(),
+		bconf.FB().Key("color").Type(bconf.Bool).Default(false).Create(),
+	).Create())
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	if err := appConfig.OnChange("change_log", "missing", func(oldValue, newValue any) {}); err == nil {
+		t.Errorf("expected error subscribing to missing field")
+	}
+
+	if err := appConfig.OnFieldSetChange("missing", func(changes []bconf.FieldChange) {}); err == nil {
+		t.Errorf("expected error subscribing to missing field-set")
+	}
+
+	levelChanges := [][2]any{}
+	fieldSetChanges := [][]bconf.FieldChange{}
+
+	_ = appConfig.OnChange("change_log", "level", func(oldValue, newValue any) {
+		levelChanges = append(levelChanges, [2]any{oldValue, newValue})
+	})
+	_ = appConfig.OnFieldSetChange("change_log", func(changes []bconf.FieldChange) {
+		fieldSetChanges = append(fieldSetChanges, changes)
+	})
+
+	if err := appConfig.SetField("change_log", "level", "debug"); err != nil {
+		t.Fatalf("unexpected error setting field: %s", err)
+	}
+
+	if len(levelChanges) != 1 || levelChanges[0] != [2]any{"info", "debug"} {
+		t.Fatalf("unexpected level changes after SetField: %v", levelChanges)
+	}
+
+	t.Setenv("CHANGE_LOG_COLOR", "true")
+
+	if errs := appConfig.LoadFieldSet("change_log"); len(errs) > 0 {
+		t.Fatalf("unexpected errors loading field-set: %v", errs)
+	}
+
+	if len(levelChanges) != 1 {
+		t.Errorf("unexpected level changes after unrelated reload: %v", levelChanges)
+	}
+
+	if len(fieldSetChanges) != 2 || len(fieldSetChanges[1]) != 1 || fieldSetChanges[1][0].FieldKey != "color" {
+		t.Fatalf("unexpected field-set changes after LoadFieldSet: %v", fieldSetChanges)
+	}
+
+	os.Unsetenv("CHANGE_LOG_COLOR")
+
+	if errs := appConfig.LoadField("change_log", "color"); len(errs) > 0 {
+		t.Fatalf("unexpected errors loading field: %v", errs)
+	}
+
+	if len(fieldSetChanges) != 3 || fieldSetChanges[2][0].NewValue != false {
+		t.Errorf("unexpected field-set changes after LoadField: %v", fieldSetChanges)
+	}
+}
@@ -1,6 +1,9 @@
 package bconf
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type FieldSets []*FieldSet
 
@@ -9,6 +12,11 @@ type FieldSet struct {
 	Key            string
 	LoadConditions LoadConditions
 	Fields         Fields
+	// Profiles defines the profiles in which the field-set is loaded, with the field-set loaded in all profiles when
+	// empty
+	Profiles []string
+	// fieldOrder tracks field keys in the order they were declared
+	fieldOrder []string
 }
 
 func (f *FieldSet) Clone() *FieldSet {
@@ -25,11 +33,20 @@ func (f *FieldSet) Clone() *FieldSet {
 		clone.Fields = make([]*Field, len(f.Fields))
 
 		for index, field := range f.Fields {
-			newField := *field
-			clone.Fields[index] = &newField
+			clone.Fields[index] = field.Clone()
 		}
 	}
 
+	if len(f.Profiles) > 0 {
+		clone.Profiles = make([]string, len(f.Profiles))
+		copy(clone.Profiles, f.Profiles)
+	}
+
+	if len(f.fieldOrder) > 0 {
+		clone.fieldOrder = make([]string, len(f.fieldOrder))
+		copy(clone.fieldOrder, f.fieldOrder)
+	}
+
 	if len(f.fieldMap) > 0 {
 		clone.fieldMap = make(map[string]*Field, len(f.fieldMap))
 
@@ -71,6 +88,14 @@ func (f *FieldSet) validate() []error {
 
 			fieldKeys[field.Key] = struct{}{}
 		}
+
+		for _, field := range f.Fields {
+			for _, alias := range field.Aliases {
+				if _, found := fieldKeys[alias]; found && alias != field.Key {
+					errs = append(errs, fmt.Errorf("field '%s' alias conflicts with field key: '%s'", field.Key, alias))
+				}
+			}
+		}
 	}
 
 	return errs
@@ -79,11 +104,145 @@ func (f *FieldSet) validate() []error {
 // initializeFieldMap transitions the FieldSet over from its configuration []*Field to a map[string]*Field.
 func (f *FieldSet) initializeFieldMap() {
 	fieldMap := make(map[string]*Field, len(f.Fields))
-	for _, field := range f.Fields {
+	fieldOrder := make([]string, len(f.Fields))
+
+	for index, field := range f.Fields {
 		fieldMap[field.Key] = field
+		fieldOrder[index] = field.Key
 	}
 
 	f.fieldMap = fieldMap
+	f.fieldOrder = fieldOrder
+}
+
+// addField adds a field to the FieldSet field map, preserving declaration order.
+func (f *FieldSet) addField(field *Field) {
+	f.fieldMap[field.Key] = field
+	f.fieldOrder = append(f.fieldOrder, field.Key)
+}
+
+// dependencyOrderedFields returns the FieldSet fields ordered so that fields follow the fields of the same field-set
+// their load conditions depend on, and are otherwise in declaration order.
+func (f *FieldSet) dependencyOrderedFields() Fields {
+	fields, _ := f.fieldLoadOrder()
+
+	return fields
+}
+
+// fieldLoadOrder returns the FieldSet fields in dependency order, and an error for every field dependency cycle. Fields
+// in a cycle are ordered after the fields they depend on outside of the cycle.
+func (f *FieldSet) fieldLoadOrder() (Fields, []error) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	fields := make(Fields, 0, len(f.fieldOrder))
+	cycleErrs := []error{}
+	cycles := map[string]struct{}{}
+	state := map[string]int{}
+	path := []string{}
+
+	var visit func(field *Field)
+	visit = func(field *Field) {
+		state[field.Key] = visiting
+		path = append(path, field.Key)
+
+		for _, loadCondition := range field.LoadConditions {
+			for _, dependency := range loadConditionDependencies(loadCondition) {
+				if dependency.FieldSetKey != "" && dependency.FieldSetKey != f.Key {
+					continue
+				}
+
+				dependencyField, found := f.fieldMap[dependency.FieldKey]
+				if !found {
+					continue
+				}
+
+				switch state[dependencyField.Key] {
+				case visiting:
+					cycleStart := 0
+					for path[cycleStart] != dependencyField.Key {
+						cycleStart++
+					}
+
+					cycle := path[cycleStart:]
+					if _, found := cycles[normalizedCycle(cycle)]; found {
+						continue
+					}
+
+					cycles[normalizedCycle(cycle)] = struct{}{}
+					cycleErrs = append(cycleErrs, fmt.Errorf(
+						"field dependency cycle detected: %s",
+						strings.Join(append(append([]string{}, cycle...), dependencyField.Key), " -> "),
+					))
+				case visited:
+					continue
+				default:
+					visit(dependencyField)
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[field.Key] = visited
+		fields = append(fields, field)
+	}
+
+	for _, field := range f.orderedFields() {
+		if state[field.Key] == 0 {
+			visit(field)
+		}
+	}
+
+	return fields, cycleErrs
+}
+
+// setProfile sets the active AppConfig profile on the FieldSet fields.
+func (f *FieldSet) setProfile(profile string) {
+	for _, field := range f.fieldMap {
+		field.profile = profile
+	}
+}
+
+// loadedInProfile returns whether the FieldSet is loaded in the given profile.
+func (f *FieldSet) loadedInProfile(profile string) bool {
+	if len(f.Profiles) < 1 {
+		return true
+	}
+
+	for _, fieldSetProfile := range f.Profiles {
+		if fieldSetProfile == profile {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeField removes a field from the FieldSet field map.
+func (f *FieldSet) removeField(fieldKey string) {
+	delete(f.fieldMap, fieldKey)
+
+	for index, key := range f.fieldOrder {
+		if key == fieldKey {
+			f.fieldOrder = append(f.fieldOrder[:index], f.fieldOrder[index+1:]...)
+			break
+		}
+	}
+}
+
+// orderedFields returns the FieldSet fields in the order they were declared.
+func (f *FieldSet) orderedFields() Fields {
+	fields := make(Fields, 0, len(f.fieldOrder))
+
+	for _, key := range f.fieldOrder {
+		if field, found := f.fieldMap[key]; found {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
 }
 
 // generateFieldDefaults runs field default generators exactly once. Multiple calls will not regenerate field defaults.
@@ -119,11 +278,8 @@ func (f *FieldSet) validateFields() []error {
 }
 
 func (f *FieldSet) fieldKeys() []string {
-	keys := []string{}
-
-	for _, field := range f.fieldMap {
-		keys = append(keys, field.Key)
-	}
+	keys := make([]string, len(f.fieldOrder))
+	copy(keys, f.fieldOrder)
 
 	return keys
 }
@@ -33,6 +33,13 @@ func (b *FieldSetBuilder) LoadConditions(value ...LoadCondition) *FieldSetBuilde
 	return b
 }
 
+func (b *FieldSetBuilder) Profiles(value ...string) *FieldSetBuilder {
+	b.init()
+	b.fieldSet.Profiles = value
+
+	return b
+}
+
 func (b *FieldSetBuilder) Create() *FieldSet {
 	b.init()
 	return b.fieldSet.Clone()
@@ -69,3 +69,11 @@ func TestFieldSetBuilderLoadConditions(t *testing.T) {
 		t.Fatalf("unexpected field key '%s', expected '%s'", fieldKey, loadConditionFieldKey)
 	}
 }
+
+func TestFieldSetBuilderProfiles(t *testing.T) {
+	fieldSet := bconf.FSB().Profiles("dev", "staging").Create()
+
+	if len(fieldSet.Profiles) != 2 {
+		t.Fatalf("unexpected profiles length '%d', expected 2", len(fieldSet.Profiles))
+	}
+}
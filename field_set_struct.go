@@ -1,5 +1,189 @@
 package bconf
 
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
 type FieldSetStruct interface {
 	FieldSet() string
 }
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// FieldSetFromStruct creates a field-set from a struct, or a pointer to a struct, so that a single struct defines the
+// field-set and is filled by FillStruct. The field-set key is the embedded ConfigStruct FieldSet value or tag. Each
+// struct field defines a field keyed by its 'bconf' tag (or the struct field name), with a type derived from the
+// struct field type, a default value from the 'default' tag, a description from the 'desc' tag, and accepted values
+// from the comma-separated 'enum' tag. The 'required' and 'sensitive' options can follow the key in the 'bconf' tag,
+// e.g. `bconf:"password,required,sensitive"`. Fields of embedded structs are added to the field-set, while nested
+// structs, slices of structs, and fields tagged with another field-set ('field-set.field') are skipped.
+func FieldSetFromStruct(configStruct any) (*FieldSet, error) {
+	structValue := reflect.Indirect(reflect.ValueOf(configStruct))
+
+	if structValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("FieldSetFromStruct expects a struct or a pointer to a struct, found '%T'", configStruct)
+	}
+
+	structType := structValue.Type()
+	fieldSetKey := ""
+
+	configStructField, found := structType.FieldByName("ConfigStruct")
+	if found && len(configStructField.Index) == 1 && configStructField.Type == configStructType {
+		fieldSetKey = configStructField.Tag.Get("bconf")
+
+		overrideValue := structValue.Field(configStructField.Index[0]).FieldByName("FieldSet")
+		if overrideValue.String() != "" {
+			fieldSetKey = overrideValue.String()
+		}
+	}
+
+	if fieldSetKey == "" {
+		return nil, fmt.Errorf("unidentified field-set for struct '%s': missing ConfigStruct tag", structType)
+	}
+
+	fields, err := structFields(structType)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating field-set '%s': %w", fieldSetKey, err)
+	}
+
+	return &FieldSet{Key: fieldSetKey, Fields: fields}, nil
+}
+
+// -- Private methods --
+
+// structFields creates the fields defined by the struct fields of a struct type, including embedded struct fields.
+func structFields(structType reflect.Type) (Fields, error) {
+	fields := Fields{}
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+
+		if structField.Name == "ConfigStruct" && structField.Type == configStructType {
+			continue
+		}
+
+		tagValue := structField.Tag.Get("bconf")
+		if tagValue == "-" || (!structField.IsExported() && !structField.Anonymous) {
+			continue
+		}
+
+		fieldType := structElemType(structField.Type)
+
+		if structField.Anonymous && isNestedStruct(fieldType) {
+			embeddedFields, err := structFields(fieldType)
+			if err != nil {
+				return nil, err
+			}
+
+			fields = append(fields, embeddedFields...)
+
+			continue
+		}
+
+		tagOptions := strings.Split(tagValue, ",")
+		if !structField.IsExported() || strings.Contains(tagOptions[0], ".") || isNestedStruct(fieldType) ||
+			(fieldType.Kind() == reflect.Slice && isNestedStruct(structElemType(fieldType.Elem()))) {
+			continue
+		}
+
+		field, err := structFieldDefinition(structField, tagOptions)
+		if err != nil {
+			return nil, fmt.Errorf("problem creating field from struct field '%s': %w", structField.Name, err)
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// structFieldDefinition creates a field from a struct field and its 'bconf' tag options.
+func structFieldDefinition(structField reflect.StructField, tagOptions []string) (*Field, error) {
+	field := &Field{Key: tagOptions[0], Description: structField.Tag.Get("desc")}
+
+	if field.Key == "" {
+		field.Key = structField.Name
+	}
+
+	fieldType, ok := structFieldType(structField.Type)
+	if !ok {
+		return nil, fmt.Errorf("unsupported struct field type '%s'", structField.Type)
+	}
+
+	field.Type = fieldType
+
+	for _, option := range tagOptions[1:] {
+		switch strings.TrimSpace(option) {
+		case "required":
+			field.Required = true
+		case "sensitive":
+			field.Sensitive = true
+		default:
+			return nil, fmt.Errorf("unsupported bconf tag option '%s'", option)
+		}
+	}
+
+	if defaultValue, found := structField.Tag.Lookup("default"); found {
+		value, err := field.parseString(defaultValue)
+		if err != nil {
+			return nil, fmt.Errorf("problem parsing default value '%s': %w", defaultValue, err)
+		}
+
+		field.Default = value
+	}
+
+	if enumeration, found := structField.Tag.Lookup("enum"); found {
+		if strings.HasPrefix(field.Type, "[]") {
+			return nil, fmt.Errorf("enum tag is not supported for field type '%s'", field.Type)
+		}
+
+		for _, elem := range strings.Split(enumeration, ",") {
+			value, err := field.parseString(strings.TrimSpace(elem))
+			if err != nil {
+				return nil, fmt.Errorf("problem parsing enum value '%s': %w", elem, err)
+			}
+
+			field.Enumeration = append(field.Enumeration, value)
+		}
+	}
+
+	return field, nil
+}
+
+// structFieldType returns the field type of a struct field type that FillStruct can fill, e.g. 'int' for a uint16
+// struct field, or 'string' for a struct field implementing encoding.TextUnmarshaler. Byte slices are not supported.
+func structFieldType(fieldType reflect.Type) (string, bool) {
+	fieldType = structElemType(fieldType)
+
+	switch {
+	case isTimeType(fieldType):
+		return Time, true
+	case fieldType == durationType:
+		return Duration, true
+	case reflect.PointerTo(fieldType).Implements(textUnmarshalerType):
+		return String, true
+	}
+
+	switch kind := fieldType.Kind(); {
+	case kind == reflect.Bool:
+		return Bool, true
+	case kind == reflect.String:
+		return String, true
+	case isFloatKind(kind):
+		return Float, true
+	case isNumericKind(kind):
+		return Int, true
+	case kind == reflect.Slice && fieldType.Elem().Kind() == reflect.Uint8:
+		// byte slices hold raw data rather than lists of numbers, so they are not mapped to '[]int' fields
+		return "", false
+	case kind == reflect.Slice && fieldType.Elem().Kind() != reflect.Pointer:
+		if elemType, ok := structFieldType(fieldType.Elem()); ok && !strings.HasPrefix(elemType, "[]") {
+			return "[]" + elemType, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,180 @@
+package bconf_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rheisen/bconf"
+)
+
+//nolint:govet // doesn't need to be optimal for tests
+type structFieldSetTimeouts struct {
+	ReadTimeout time.Duration `bconf:"read_timeout" default:"5s"`
+}
+
+//nolint:govet // doesn't need to be optimal for tests
+type structFieldSetConfig struct {
+	bconf.ConfigStruct `bconf:"struct_log"`
+	structFieldSetTimeouts
+	Level    fillTestLogLevel  `bconf:"level" default:"info" desc:"Logging level" enum:"debug,info,warn"`
+	Port     uint16            `bconf:"port" default:"8080"`
+	Ratio    float32           `bconf:"ratio" default:"0.25"`
+	Tags     []string          `bconf:"tags" default:"a,b"`
+	Password string            `bconf:"password,required,sensitive"`
+	Name     *string           `bconf:"name"`
+	Started  time.Time         `bconf:"struct_other.started"`
+	DB       fillTestDBConfig  `bconf:"struct_db"`
+	Address  fillTestHostPort  `bconf:"address" default:"localhost:5432"`
+	Expires  fillTestTimestamp `bconf:"expires" default:"2024-01-02T03:04:05Z"`
+	Ignored  string            `bconf:"-"`
+	internal string
+}
+
+func TestFieldSetFromStruct(t *testing.T) {
+	fieldSet, err := bconf.FieldSetFromStruct(&structFieldSetConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error creating field-set from struct: %s", err)
+	}
+
+	if fieldSet.Key != "struct_log" {
+		t.Errorf("unexpected field-set key: %s", fieldSet.Key)
+	}
+
+	fieldKeys := make([]string, len(fieldSet.Fields))
+	fieldsByKey := map[string]*bconf.Field{}
+
+	for index, field := range fieldSet.Fields {
+		fieldKeys[index] = field.Key
+		fieldsByKey[field.Key] = field
+	}
+
+	if strings.Join(fieldKeys, ",") != "read_timeout,level,port,ratio,tags,password,name,address,expires" {
+		t.Fatalf("unexpected field keys: %v", fieldKeys)
+	}
+
+	level := fieldsByKey["level"]
+	if level.Type != bconf.String || level.Default != "info" || level.Description != "Logging level" ||
+		len(level.Enumeration) != 3 || level.Enumeration[2] != "warn" {
+		t.Errorf("unexpected level field: %+v", level)
+	}
+
+	if fieldsByKey["port"].Type != bconf.Int || fieldsByKey["port"].Default != 8080 {
+		t.Errorf("unexpected port field: %+v", fieldsByKey["port"])
+	}
+
+	if fieldsByKey["ratio"].Type != bconf.Float || fieldsByKey["tags"].Type != bconf.Strings ||
+		fieldsByKey["read_timeout"].Type != bconf.Duration || fieldsByKey["name"].Type != bconf.String ||
+		fieldsByKey["address"].Type != bconf.String || fieldsByKey["expires"].Type != bconf.Time {
+		t.Errorf("unexpected field types: %+v", fieldSet.Fields)
+	}
+
+	if !fieldsByKey["password"].Required || !fieldsByKey["password"].Sensitive {
+		t.Errorf("unexpected password field options: %+v", fieldsByKey["password"])
+	}
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	appConfig.SetLoaders(&bconf.EnvironmentLoader{KeyPrefix: "bconf_struct_test"})
+
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	errs := appConfig.AddFieldSets(
+		fieldSet,
+		bconf.FSB().Key("struct_other").Fields(
+			bconf.FB().Key("started").Type(bconf.Time).Default(started).Create(),
+		).Create(),
+		bconf.FSB().Key("struct_db").Fields(
+			bconf.FB().Key("host").Type(bconf.String).Default("db.local").Create(),
+			bconf.FB().Key("port").Type(bconf.Int).Default(5432).Create(),
+		).Create(),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	t.Setenv("BCONF_STRUCT_TEST_STRUCT_LOG_PASSWORD", "secret")
+	t.Setenv("BCONF_STRUCT_TEST_STRUCT_LOG_LEVEL", "debug")
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	// the struct the field-set was created from is filled by the app-config
+	configStruct := &structFieldSetConfig{}
+	if err := appConfig.FillStruct(configStruct); err != nil {
+		t.Fatalf("unexpected error filling struct: %s", err)
+	}
+
+	if configStruct.Level != "debug" || configStruct.Port != 8080 || configStruct.Ratio != 0.25 ||
+		configStruct.Password != "secret" || configStruct.Name != nil || configStruct.ReadTimeout != 5*time.Second ||
+		configStruct.Address.Port != "5432" || !time.Time(configStruct.Expires).Equal(started) {
+		t.Errorf("unexpected struct values: %+v", configStruct)
+	}
+
+	if len(configStruct.Tags) != 2 || configStruct.Tags[1] != "b" {
+		t.Errorf("unexpected struct slice values: %v", configStruct.Tags)
+	}
+
+	if !configStruct.Started.Equal(started) || configStruct.DB.Host != "db.local" || configStruct.DB.Port != 5432 {
+		t.Errorf("unexpected values from other field-sets: %s, %+v", configStruct.Started, configStruct.DB)
+	}
+
+	if configStruct.Ignored != "" || configStruct.internal != "" {
+		t.Errorf("unexpected values for ignored struct fields: %+v", configStruct)
+	}
+}
+
+func TestFieldSetFromStructErrors(t *testing.T) {
+	if _, err := bconf.FieldSetFromStruct("value"); err == nil {
+		t.Errorf("expected error creating field-set from non-struct value")
+	}
+
+	if _, err := bconf.FieldSetFromStruct(&struct{ Host string }{}); err == nil ||
+		!strings.Contains(err.Error(), "unidentified field-set") {
+		t.Errorf("expected unidentified field-set error, got: %v", err)
+	}
+
+	override, err := bconf.FieldSetFromStruct(&struct {
+		bconf.ConfigStruct `bconf:"struct_tag"`
+		Host               string `bconf:"host"`
+	}{ConfigStruct: bconf.ConfigStruct{FieldSet: "struct_override"}})
+	if err != nil || override.Key != "struct_override" {
+		t.Errorf("expected ConfigStruct FieldSet override, got: %v, %v", override, err)
+	}
+
+	invalidDefault := &struct {
+		bconf.ConfigStruct `bconf:"struct_err"`
+		Port               int `bconf:"port" default:"http"`
+	}{}
+	if _, err := bconf.FieldSetFromStruct(invalidDefault); err == nil ||
+		!strings.Contains(err.Error(), "problem parsing default value 'http'") {
+		t.Errorf("expected invalid default error, got: %v", err)
+	}
+
+	invalidOption := &struct {
+		bconf.ConfigStruct `bconf:"struct_err"`
+		Port               int `bconf:"port,optional"`
+	}{}
+	if _, err := bconf.FieldSetFromStruct(invalidOption); err == nil ||
+		!strings.Contains(err.Error(), "unsupported bconf tag option 'optional'") {
+		t.Errorf("expected invalid tag option error, got: %v", err)
+	}
+
+	unsupportedType := &struct {
+		bconf.ConfigStruct `bconf:"struct_err"`
+		Values             map[string]string `bconf:"values"`
+	}{}
+	if _, err := bconf.FieldSetFromStruct(unsupportedType); err == nil ||
+		!strings.Contains(err.Error(), "unsupported struct field type 'map[string]string'") {
+		t.Errorf("expected unsupported type error, got: %v", err)
+	}
+
+	byteSlice := &struct {
+		bconf.ConfigStruct `bconf:"struct_err"`
+		Data               []byte `bconf:"data"`
+	}{}
+	if _, err := bconf.FieldSetFromStruct(byteSlice); err == nil ||
+		!strings.Contains(err.Error(), "unsupported struct field type '[]uint8'") {
+		t.Errorf("expected unsupported byte slice type error, got: %v", err)
+	}
+}
@@ -17,6 +17,8 @@ func NewFlagLoaderWithKeyPrefix(keyPrefix string) *FlagLoader {
 type FlagLoader struct {
 	KeyPrefix      string
 	OverrideLookup []string
+	// Strict reports parsed flags that do not map to a field
+	Strict bool
 }
 
 func (l *FlagLoader) Clone() *FlagLoader {
@@ -38,14 +40,7 @@ func (l *FlagLoader) Name() string {
 }
 
 func (l *FlagLoader) Get(fieldSetKey, fieldKey string) (string, bool) {
-	values := l.flagValues()
-
-	value, found := values[fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)]
-	if found {
-		return value, true
-	}
-
-	return "", false
+	return l.flagValue(l.flagValues(), fieldSetKey, fieldKey)
 }
 
 func (l *FlagLoader) GetMap(fieldSetKey string, fieldKeys []string) map[string]string {
@@ -54,7 +49,7 @@ func (l *FlagLoader) GetMap(fieldSetKey string, fieldKeys []string) map[string]s
 	flagValues := l.flagValues()
 
 	for _, fieldKey := range fieldKeys {
-		value, found := flagValues[fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)]
+		value, found := l.flagValue(flagValues, fieldSetKey, fieldKey)
 		if found {
 			values[fieldKey] = value
 		}
@@ -64,7 +59,47 @@ func (l *FlagLoader) GetMap(fieldSetKey string, fieldKeys []string) map[string]s
 }
 
 func (l *FlagLoader) HelpString(fieldSetKey, fieldKey string) string {
-	return fmt.Sprintf("Flag argument: '--%s'", l.flagKey(fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)))
+	return fmt.Sprintf("Flag argument: '--%s'", l.SourceKey(fieldSetKey, fieldKey))
+}
+
+// SourceKey returns the flag name for a field, including the KeyPrefix when set, e.g. 'prefix_log_level'.
+func (l *FlagLoader) SourceKey(fieldSetKey, fieldKey string) string {
+	return l.flagKey(fmt.Sprintf("%s_%s", fieldSetKey, fieldKey))
+}
+
+// SourceKeys returns the names of all parsed flags, excluding the help flags handled by the AppConfig. Flags given
+// without the KeyPrefix are returned with the KeyPrefix, matching the SourceKey they are looked up with.
+func (l *FlagLoader) SourceKeys() []string {
+	keys := []string{}
+
+	for key := range l.flagValues() {
+		if key == "h" || key == "help" || key == printConfigFlag {
+			continue
+		}
+
+		if l.KeyPrefix != "" && !strings.HasPrefix(strings.ToLower(key), strings.ToLower(l.KeyPrefix)+"_") {
+			key = l.flagKey(key)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func (l *FlagLoader) StrictMode() bool {
+	return l.Strict
+}
+
+// flagValue returns the value of a field flag, looked up with the KeyPrefix first, and without it otherwise.
+func (l *FlagLoader) flagValue(values map[string]string, fieldSetKey, fieldKey string) (string, bool) {
+	if value, found := values[l.SourceKey(fieldSetKey, fieldKey)]; found {
+		return value, true
+	}
+
+	value, found := values[fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)]
+
+	return value, found
 }
 
 func (l *FlagLoader) flagKey(key string) string {
@@ -2,6 +2,8 @@ package bconf_test
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
@@ -199,3 +201,37 @@ func TestFlagLoaderWithKeyPrefix(t *testing.T) {
 		t.Errorf("unexpected value for session_key from loader clone: '%s'", cloneSessionKeyLookup)
 	}
 }
+
+func TestFlagLoaderSourceKeys(t *testing.T) {
+	loader := bconf.FlagLoader{
+		OverrideLookup: []string{"--app_id=app-id", "--log_level", "info", "-h", "--help"},
+		Strict:         true,
+	}
+
+	if sourceKey := loader.SourceKey("app", "id"); sourceKey != "app_id" {
+		t.Errorf("unexpected source key: '%s'", sourceKey)
+	}
+
+	sourceKeys := loader.SourceKeys()
+	if len(sourceKeys) != 2 {
+		t.Fatalf("unexpected source keys length '%d', expected '2': %v", len(sourceKeys), sourceKeys)
+	}
+
+	if !loader.StrictMode() {
+		t.Errorf("expected strict mode for loader")
+	}
+
+	loader.KeyPrefix = "ext"
+	loader.OverrideLookup = []string{"--ext_app_id=app-id", "--log_level", "info"}
+
+	if sourceKey := loader.SourceKey("app", "id"); sourceKey != "ext_app_id" {
+		t.Errorf("unexpected source key with key prefix: '%s'", sourceKey)
+	}
+
+	sourceKeys = loader.SourceKeys()
+	sort.Strings(sourceKeys)
+
+	if !reflect.DeepEqual(sourceKeys, []string{"ext_app_id", "ext_log_level"}) {
+		t.Errorf("unexpected source keys with key prefix: %v", sourceKeys)
+	}
+}
@@ -0,0 +1,356 @@
+package bconf
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	HelpFormatText = "text"
+	HelpFormatJSON = "json"
+)
+
+// helpTabWidth is the column width tabs are expanded to when wrapping help text.
+const helpTabWidth = 8
+
+// HelpModel describes the help output of an AppConfig, with fields grouped into the required, conditionally required,
+// and optional configuration sections.
+type HelpModel struct {
+	// AppName is the app name, or the program name when the app name is empty
+	AppName        string        `json:"app_name"`
+	AppDescription string        `json:"app_description,omitempty"`
+	Sections       []HelpSection `json:"sections"`
+}
+
+// HelpSection describes a help section, e.g. 'Required Configuration', with fields sorted by key.
+type HelpSection struct {
+	Title  string      `json:"title"`
+	Fields []HelpField `json:"fields"`
+}
+
+// HelpField describes a field in the help output, with values formatted as they are in HelpString, and values from
+// Sensitive fields redacted.
+type HelpField struct {
+	// Key is the field-set key and field key joined by an underscore, e.g. 'log_level'
+	Key                string               `json:"key"`
+	FieldSetKey        string               `json:"field_set"`
+	FieldKey           string               `json:"field"`
+	Type               string               `json:"type"`
+	Description        string               `json:"description,omitempty"`
+	DeprecationMessage string               `json:"deprecation_message,omitempty"`
+	Default            string               `json:"default,omitempty"`
+	Enumeration        []string             `json:"enumeration,omitempty"`
+	ProfileDefaults    []HelpProfileDefault `json:"profile_defaults,omitempty"`
+	RequiredInProfiles []string             `json:"required_in_profiles,omitempty"`
+	LoaderKeys         []HelpLoaderKey      `json:"loader_keys,omitempty"`
+	// Profiles are the profiles the field-set is loaded in, with the field-set loaded in all profiles when empty
+	Profiles []string `json:"profiles,omitempty"`
+	// LoadConditions are the field-set and field load conditions
+	LoadConditions []HelpLoadCondition `json:"load_conditions,omitempty"`
+	Required       bool                `json:"required"`
+	Deprecated     bool                `json:"deprecated,omitempty"`
+	HasDefault     bool                `json:"has_default,omitempty"`
+	// DefaultGenerated identifies fields with a default value generated at run-time
+	DefaultGenerated bool `json:"default_generated,omitempty"`
+	// SelectsProfile identifies the field set with AppConfig.SetProfileField
+	SelectsProfile bool `json:"selects_profile,omitempty"`
+}
+
+// HelpProfileDefault describes the default value of a field in a profile.
+type HelpProfileDefault struct {
+	Profile string `json:"profile"`
+	Value   string `json:"value"`
+}
+
+// HelpLoaderKey describes the loader help string of a field, or of a deprecated field alias.
+type HelpLoaderKey struct {
+	Loader     string `json:"loader"`
+	HelpString string `json:"help"`
+	Alias      bool   `json:"alias,omitempty"`
+}
+
+// HelpLoadCondition describes a load condition by its description, or by the field it depends on when the condition
+// is not described.
+type HelpLoadCondition struct {
+	Description string `json:"description,omitempty"`
+	FieldSetKey string `json:"field_set,omitempty"`
+	FieldKey    string `json:"field,omitempty"`
+}
+
+// HelpModel returns the help model of every field-set.
+func (c *AppConfig) HelpModel() *HelpModel {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	return c.helpModel("")
+}
+
+// FieldSetHelpModel returns the help model of a single field-set.
+func (c *AppConfig) FieldSetHelpModel(fieldSetKey string) (*HelpModel, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	if _, found := c.fieldSets[fieldSetKey]; !found {
+		return nil, c.unknownHelpFieldSetError(fieldSetKey)
+	}
+
+	return c.helpModel(fieldSetKey), nil
+}
+
+// -- Private methods --
+
+func (c *AppConfig) helpModel(fieldSetKey string) *HelpModel {
+	model := &HelpModel{AppName: c.appName, AppDescription: c.appDescription, Sections: []HelpSection{}}
+
+	if model.AppName == "" {
+		model.AppName = os.Args[0]
+	}
+
+	fields := c.fields(fieldSetKey)
+
+	for _, section := range helpSections(fields) {
+		helpSection := HelpSection{Title: section.title, Fields: make([]HelpField, len(section.keys))}
+
+		for index, key := range section.keys {
+			helpSection.Fields[index] = c.helpField(key, fields[key])
+		}
+
+		model.Sections = append(model.Sections, helpSection)
+	}
+
+	return model
+}
+
+func (c *AppConfig) helpField(key string, entry *fieldEntry) HelpField {
+	field := entry.field
+	helpField := HelpField{
+		Key:                key,
+		FieldSetKey:        entry.fieldSetKey,
+		FieldKey:           field.Key,
+		Type:               field.Type,
+		Description:        field.Description,
+		Deprecated:         field.Deprecated,
+		DeprecationMessage: field.DeprecationMessage,
+		Required:           field.Required,
+		RequiredInProfiles: field.RequiredInProfiles,
+		DefaultGenerated:   field.DefaultGenerator != nil,
+		SelectsProfile:     entry.fieldSetKey == c.profileFieldSet && field.Key == c.profileField,
+		Profiles:           entry.profiles,
+	}
+
+	for _, value := range field.Enumeration {
+		helpField.Enumeration = append(helpField.Enumeration, fmt.Sprintf("%v", value))
+	}
+
+	// generated defaults are described by DefaultGenerated
+	for _, fieldDefault := range field.displayDefaults() {
+		switch {
+		case fieldDefault.generated:
+			continue
+		case fieldDefault.profile != "":
+			helpField.ProfileDefaults = append(
+				helpField.ProfileDefaults,
+				HelpProfileDefault{Profile: fieldDefault.profile, Value: fieldDefault.value},
+			)
+		default:
+			helpField.HasDefault = true
+			helpField.Default = fieldDefault.value
+		}
+	}
+
+	for _, loader := range c.loaders {
+		if helpString := loader.HelpString(entry.fieldSetKey, field.Key); helpString != "" {
+			helpField.LoaderKeys = append(helpField.LoaderKeys, HelpLoaderKey{Loader: loader.Name(), HelpString: helpString})
+		}
+
+		for _, alias := range field.Aliases {
+			if aliasHelpString := loader.HelpString(field.aliasLocation(alias, entry.fieldSetKey)); aliasHelpString != "" {
+				helpField.LoaderKeys = append(
+					helpField.LoaderKeys,
+					HelpLoaderKey{Loader: loader.Name(), HelpString: aliasHelpString, Alias: true},
+				)
+			}
+		}
+	}
+
+	for _, condition := range entry.loadConditions {
+		helpCondition := HelpLoadCondition{}
+
+		if describedCondition, ok := condition.(DescribedLoadCondition); ok {
+			helpCondition.Description = describedCondition.Description()
+		} else {
+			helpCondition.FieldSetKey, helpCondition.FieldKey = condition.FieldDependency()
+		}
+
+		helpField.LoadConditions = append(helpField.LoadConditions, helpCondition)
+	}
+
+	return helpField
+}
+
+func (c *AppConfig) unknownHelpFieldSetError(fieldSetKey string) error {
+	if suggestion := closestKey(fieldSetKey, c.fieldSetKeys()); suggestion != "" {
+		return fmt.Errorf("field-set with key '%s' not found (did you mean '%s'?)", fieldSetKey, suggestion)
+	}
+
+	return fmt.Errorf("field-set with key '%s' not found", fieldSetKey)
+}
+
+func (c *AppConfig) fieldSetKeys() []string {
+	keys := make([]string, len(c.orderedFieldSets))
+
+	for index, fieldSet := range c.orderedFieldSets {
+		keys[index] = fieldSet.Key
+	}
+
+	return keys
+}
+
+// helpFlagRequest finds the -h or --help flag anywhere in the arguments before a '--' terminator, returning the help
+// format (--help=json or --help=text), and the field-set key when the flag is followed by one of the field-set keys.
+// Other arguments following the flag, e.g. positional program arguments, are ignored.
+func helpFlagRequest(args, fieldSetKeys []string) (format, fieldSetKey string, found bool) {
+	for index, arg := range args {
+		if arg == "--" {
+			return "", "", false
+		}
+
+		switch {
+		case arg == "-h" || arg == "--help":
+			format = HelpFormatText
+		case strings.HasPrefix(arg, "--help="):
+			format = strings.TrimPrefix(arg, "--help=")
+		default:
+			continue
+		}
+
+		if index+1 < len(args) {
+			for _, key := range fieldSetKeys {
+				if args[index+1] == key {
+					fieldSetKey = key
+				}
+			}
+		}
+
+		return format, fieldSetKey, true
+	}
+
+	return "", "", false
+}
+
+// printHelp prints the help output in the requested format, and exits.
+func (c *AppConfig) printHelp(format, fieldSetKey string) {
+	var output string
+
+	var err error
+
+	switch format {
+	case HelpFormatText, "":
+		if fieldSetKey == "" {
+			output = c.HelpString()
+		} else {
+			output, err = c.FieldSetHelpString(fieldSetKey)
+		}
+
+		output = wrapHelpText(output, helpWidth())
+	case HelpFormatJSON:
+		model := c.HelpModel()
+		if fieldSetKey != "" {
+			model, err = c.FieldSetHelpModel(fieldSetKey)
+		}
+
+		if err == nil {
+			var modelBytes []byte
+
+			modelBytes, err = json.MarshalIndent(model, "", "  ")
+			output = string(modelBytes) + "\n"
+		}
+	default:
+		err = fmt.Errorf("unsupported help format: '%s'", format)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+	os.Exit(0)
+}
+
+// helpWidth returns the width help text is wrapped to, from the COLUMNS environment variable or the terminal, or 0
+// when stdout is not a terminal.
+func helpWidth() int {
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+
+	return terminalWidth(os.Stdout)
+}
+
+// wrapHelpText wraps lines longer than width at spaces, indenting continuation lines two spaces past the line
+// indentation. Text is returned unchanged when width is not positive.
+func wrapHelpText(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+
+	lines := strings.Split(text, "\n")
+	wrapped := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		if textWidth(line) <= width {
+			wrapped = append(wrapped, line)
+			continue
+		}
+
+		content := strings.TrimLeft(line, " \t")
+		indent := line[:len(line)-len(content)]
+		continuationIndent := indent + "  "
+		current := indent
+		currentWidth := textWidth(indent)
+		lineStart := true
+
+		for _, word := range strings.Fields(content) {
+			if !lineStart && currentWidth+1+utf8.RuneCountInString(word) > width {
+				wrapped = append(wrapped, current)
+				current = continuationIndent + word
+				currentWidth = textWidth(continuationIndent) + utf8.RuneCountInString(word)
+
+				continue
+			}
+
+			if !lineStart {
+				current += " "
+				currentWidth++
+			}
+
+			current += word
+			currentWidth += utf8.RuneCountInString(word)
+			lineStart = false
+		}
+
+		wrapped = append(wrapped, current)
+	}
+
+	return strings.Join(wrapped, "\n")
+}
+
+// textWidth returns the display width of text, with tabs expanded to the next tab stop.
+func textWidth(text string) int {
+	width := 0
+
+	for _, character := range text {
+		if character == '\t' {
+			width += helpTabWidth - width%helpTabWidth
+		} else {
+			width++
+		}
+	}
+
+	return width
+}
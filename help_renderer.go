@@ -0,0 +1,124 @@
+package bconf
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// DefaultHelpTemplate is the text/template used by HelpString when no help renderer is set. It is executed with a
+// *HelpModel, and can use the 'join' function (strings.Join with the arguments reversed, for use in pipelines).
+const DefaultHelpTemplate = `Usage of '{{ .AppName }}':
+{{ if .AppDescription }}{{ .AppDescription }}
+
+{{ end }}
+{{- range .Sections }}{{ .Title }}:
+{{ range .Fields }}	{{ .Key }} {{ .Type }}
+{{ if .Description }}		{{ .Description }}
+{{ end }}
+{{- if and .Deprecated .DeprecationMessage }}		Deprecated: {{ .DeprecationMessage }}
+{{ else if .Deprecated }}		Deprecated
+{{ end }}
+{{- if .Enumeration }}		Accepted values: [{{ range $index, $value := .Enumeration
+	}}{{ if $index }}, {{ end }}'{{ $value }}'{{ end }}]
+{{ end }}
+{{- if .HasDefault }}		Default value: '{{ .Default }}'
+{{ end }}
+{{- if .DefaultGenerated }}		Default value: <generated-at-run-time>
+{{ end }}
+{{- range .ProfileDefaults }}		Default value ({{ .Profile }}): '{{ .Value }}'
+{{ end }}
+{{- if .RequiredInProfiles }}		Required in profiles: {{ .RequiredInProfiles | join ", " }}
+{{ end }}
+{{- if .SelectsProfile }}		Selects the configuration profile
+{{ end }}
+{{- range .LoaderKeys }}		{{ .HelpString }}{{ if .Alias }} (deprecated alias){{ end }}
+{{ end }}
+{{- if .Profiles }}		Loaded in profiles: {{ .Profiles | join ", " }}
+{{ end }}
+{{- range .LoadConditions }}
+{{- if .Description }}		Loaded when {{ .Description }}
+{{ else if and .FieldSetKey .FieldKey }}		Loading depends on field: '{{ .FieldSetKey }}_{{ .FieldKey }}'
+{{ else }}		Loading depends on: <custom-load-condition-function>
+{{ end }}
+{{- end }}
+{{- end }}
+{{- end }}`
+
+// HelpRenderer renders the help model, and can be set with AppConfig.SetHelpRenderer to change the help output.
+type HelpRenderer interface {
+	RenderHelp(model *HelpModel) (string, error)
+}
+
+// HelpTemplateRenderer renders the help model with a text/template.
+type HelpTemplateRenderer struct {
+	Template *template.Template
+}
+
+// NewHelpTemplateRenderer parses a help template, which is executed with a *HelpModel and can use the functions
+// available to DefaultHelpTemplate.
+func NewHelpTemplateRenderer(templateText string) (*HelpTemplateRenderer, error) {
+	helpTemplate, err := template.New("help").Funcs(helpTemplateFuncs()).Parse(templateText)
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing help template: %w", err)
+	}
+
+	return &HelpTemplateRenderer{Template: helpTemplate}, nil
+}
+
+func (r *HelpTemplateRenderer) RenderHelp(model *HelpModel) (string, error) {
+	builder := strings.Builder{}
+
+	if err := r.Template.Execute(&builder, model); err != nil {
+		return "", fmt.Errorf("problem rendering help template: %w", err)
+	}
+
+	return builder.String(), nil
+}
+
+// SetHelpRenderer sets the renderer used by HelpString, FieldSetHelpString, and the help flag handled by Register.
+// Setting a nil renderer restores the default help template.
+func (c *AppConfig) SetHelpRenderer(renderer HelpRenderer) {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	c.helpRenderer = renderer
+}
+
+// -- Private methods --
+
+var defaultHelpRenderer = func() *HelpTemplateRenderer {
+	renderer, err := NewHelpTemplateRenderer(DefaultHelpTemplate)
+	if err != nil {
+		panic(err)
+	}
+
+	return renderer
+}()
+
+// renderHelp renders the help model with the help renderer, falling back to the default help template when no help
+// renderer is set, or when the help renderer returns an error, which is passed to the warning handler.
+func (c *AppConfig) renderHelp(model *HelpModel) string {
+	if c.helpRenderer != nil {
+		helpString, err := c.helpRenderer.RenderHelp(model)
+		if err == nil {
+			return helpString
+		}
+
+		if c.warningHandler != nil {
+			c.warningHandler(err.Error())
+		}
+	}
+
+	helpString, _ := defaultHelpRenderer.RenderHelp(model)
+
+	return helpString
+}
+
+func helpTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"join": func(separator string, values []string) string {
+			return strings.Join(values, separator)
+		},
+	}
+}
@@ -0,0 +1,112 @@
+package bconf_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigHelpTemplateRenderer(t *testing.T) {
+	appConfig := helpTestAppConfig()
+
+	renderer, err := bconf.NewHelpTemplateRenderer(
+		"{{ .AppName }}\n{{ range .Sections }}## {{ .Title }}\n{{ range .Fields }}- {{ .Key }}" +
+			"{{ range .LoaderKeys }} [{{ .HelpString }}]{{ end }}\n{{ end }}{{ end }}",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error parsing help template: %s", err)
+	}
+
+	appConfig.SetHelpRenderer(renderer)
+
+	expected := "app\n## Required Configuration\n- help_api_host [Environment key: 'HELP_API_HOST']\n" +
+		"## Optional Configuration\n- help_api_token [Environment key: 'HELP_API_TOKEN']\n" +
+		"- help_log_format [Environment key: 'HELP_LOG_FORMAT']\n- help_log_level [Environment key: 'HELP_LOG_LEVEL']\n"
+	if helpString := appConfig.HelpString(); helpString != expected {
+		t.Errorf("unexpected help string from template renderer:\n%s", helpString)
+	}
+
+	fieldSetHelpString, err := appConfig.FieldSetHelpString("help_log")
+	if err != nil {
+		t.Fatalf("unexpected error getting field-set help string: %s", err)
+	}
+
+	if strings.Contains(fieldSetHelpString, "help_api") || !strings.Contains(fieldSetHelpString, "help_log_level") {
+		t.Errorf("unexpected field-set help string from template renderer:\n%s", fieldSetHelpString)
+	}
+
+	appConfig.SetHelpRenderer(nil)
+
+	helpString := appConfig.HelpString()
+	if !strings.Contains(helpString, "Required Configuration:\n\thelp_api_host string\n") {
+		t.Errorf("expected default help template after resetting renderer:\n%s", helpString)
+	}
+
+	if _, err := bconf.NewHelpTemplateRenderer("{{ .AppName "); err == nil {
+		t.Errorf("expected error parsing invalid help template")
+	}
+}
+
+func TestAppConfigHelpRenderer(t *testing.T) {
+	appConfig := helpTestAppConfig()
+	appConfig.SetHelpRenderer(helpTestRenderer{})
+
+	if helpString := appConfig.HelpString(); helpString != "app: 2 sections" {
+		t.Errorf("unexpected help string from renderer: %s", helpString)
+	}
+
+	warnings := []string{}
+	appConfig.SetWarningHandler(func(warning string) {
+		warnings = append(warnings, warning)
+	})
+	appConfig.SetHelpRenderer(helpTestRenderer{err: fmt.Errorf("render failure")})
+
+	if helpString := appConfig.HelpString(); !strings.HasPrefix(helpString, "Usage of 'app':\n") {
+		t.Errorf("expected default help output when renderer fails: %s", helpString)
+	}
+
+	if len(warnings) != 1 || warnings[0] != "render failure" {
+		t.Errorf("expected renderer error warning, got: %v", warnings)
+	}
+
+	fieldSetHelpString, err := appConfig.FieldSetHelpString("help_log")
+	if err != nil || !strings.HasPrefix(fieldSetHelpString, "Usage of 'app':\n") {
+		t.Errorf("expected default field-set help output when renderer fails (error: %v): %s", err, fieldSetHelpString)
+	}
+
+	if len(warnings) != 2 || warnings[1] != "render failure" {
+		t.Errorf("expected field-set renderer error warning, got: %v", warnings)
+	}
+}
+
+func TestDefaultHelpTemplate(t *testing.T) {
+	appConfig := helpTestAppConfig()
+
+	renderer, err := bconf.NewHelpTemplateRenderer(bconf.DefaultHelpTemplate)
+	if err != nil {
+		t.Fatalf("unexpected error parsing default help template: %s", err)
+	}
+
+	rendered, err := renderer.RenderHelp(appConfig.HelpModel())
+	if err != nil {
+		t.Fatalf("unexpected error rendering default help template: %s", err)
+	}
+
+	if rendered != appConfig.HelpString() {
+		t.Errorf("expected default help template to match help string:\n%s\n%s", rendered, appConfig.HelpString())
+	}
+}
+
+type helpTestRenderer struct {
+	err error
+}
+
+func (r helpTestRenderer) RenderHelp(model *bconf.HelpModel) (string, error) {
+	if r.err != nil {
+		return "", r.err
+	}
+
+	return fmt.Sprintf("%s: %d sections", model.AppName, len(model.Sections)), nil
+}
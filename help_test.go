@@ -0,0 +1,177 @@
+package bconf_test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigHelpModel(t *testing.T) {
+	appConfig := helpTestAppConfig()
+
+	model := appConfig.HelpModel()
+	if model.AppName != "app" || model.AppDescription != "Application description" {
+		t.Errorf("unexpected help model app attributes: %+v", model)
+	}
+
+	if len(model.Sections) != 2 || model.Sections[0].Title != "Required Configuration" ||
+		model.Sections[1].Title != "Optional Configuration" {
+		t.Fatalf("unexpected help model sections: %+v", model.Sections)
+	}
+
+	token := model.Sections[1].Fields[0]
+	if token.Key != "help_api_token" || token.Default != "<sensitive-value>" || token.Required {
+		t.Errorf("unexpected help model token field: %+v", token)
+	}
+
+	level := model.Sections[1].Fields[2]
+	if level.Key != "help_log_level" || level.Default != "info" || len(level.Enumeration) != 2 {
+		t.Errorf("unexpected help model level field: %+v", level)
+	}
+
+	if len(level.LoaderKeys) != 1 || level.LoaderKeys[0].HelpString != "Environment key: 'HELP_LOG_LEVEL'" {
+		t.Errorf("unexpected help model loader keys: %+v", level.LoaderKeys)
+	}
+
+	format := model.Sections[1].Fields[1]
+	if len(format.LoadConditions) != 1 || format.LoadConditions[0].Description != "level is set" {
+		t.Errorf("unexpected help model load conditions: %+v", format.LoadConditions)
+	}
+
+	fieldSetModel, err := appConfig.FieldSetHelpModel("help_api")
+	if err != nil {
+		t.Fatalf("unexpected error getting field-set help model: %s", err)
+	}
+
+	if len(fieldSetModel.Sections) != 2 || fieldSetModel.Sections[0].Fields[0].Key != "help_api_host" {
+		t.Errorf("unexpected field-set help model sections: %+v", fieldSetModel.Sections)
+	}
+
+	if _, err := appConfig.FieldSetHelpModel("help_apx"); err == nil ||
+		!strings.Contains(err.Error(), "did you mean 'help_api'?") {
+		t.Errorf("expected error with suggestion for unknown field-set, got: %v", err)
+	}
+}
+
+func TestAppConfigFieldSetHelpString(t *testing.T) {
+	appConfig := helpTestAppConfig()
+
+	helpString, err := appConfig.FieldSetHelpString("help_log")
+	if err != nil {
+		t.Fatalf("unexpected error getting field-set help string: %s", err)
+	}
+
+	if !strings.Contains(helpString, "help_log_level string") || strings.Contains(helpString, "help_api") {
+		t.Errorf("unexpected field-set help string: %s", helpString)
+	}
+
+	if _, err := appConfig.FieldSetHelpString("unknown"); err == nil {
+		t.Errorf("expected error getting help string of unknown field-set")
+	}
+}
+
+func TestAppConfigRegisterHelpFlag(t *testing.T) {
+	if args, found := os.LookupEnv("BCONF_HELP_TEST_ARGS"); found {
+		os.Args = append([]string{"app"}, strings.Fields(args)...)
+		helpTestAppConfig().Register(true)
+
+		return
+	}
+
+	tests := []struct {
+		args     string
+		columns  string
+		expected []string
+		excluded []string
+		failure  bool
+	}{
+		{
+			args:     "--help_log_level=debug --help",
+			expected: []string{"Usage of 'app':", "help_api_host string", "help_log_level string"},
+		},
+		{
+			args:     "-h help_log",
+			expected: []string{"help_log_level string"},
+			excluded: []string{"help_api_host"},
+		},
+		{
+			args:     "--help=json help_api",
+			expected: []string{`"key": "help_api_host"`, `"title": "Required Configuration"`},
+			excluded: []string{"help_log_level"},
+		},
+		{
+			args:     "--help serve",
+			expected: []string{"help_api_host string", "help_log_level string"},
+		},
+		{
+			args:     "serve -h help_log",
+			expected: []string{"help_log_level string"},
+			excluded: []string{"help_api_host"},
+		},
+		{
+			args:    "--help",
+			columns: "40",
+			expected: []string{
+				"\t\tThe host of the API that\n\t\t  requests are sent to\n",
+				"\t\tNiveau de journalisation\n\t\t  pour événements créés\n",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestAppConfigRegisterHelpFlag$")
+		cmd.Env = append(os.Environ(), "BCONF_HELP_TEST_ARGS="+test.args, "COLUMNS="+test.columns)
+
+		output, err := cmd.CombinedOutput()
+		if test.failure != (err != nil) {
+			t.Errorf("unexpected exit for args '%s' (error: %v): %s", test.args, err, output)
+		}
+
+		for _, expected := range test.expected {
+			if !strings.Contains(string(output), expected) {
+				t.Errorf("expected help output for args '%s' to contain '%s': %s", test.args, expected, output)
+			}
+		}
+
+		for _, excluded := range test.excluded {
+			if strings.Contains(string(output), excluded) {
+				t.Errorf("unexpected '%s' in help output for args '%s': %s", excluded, test.args, output)
+			}
+		}
+	}
+}
+
+func TestAppConfigHelpModelJSON(t *testing.T) {
+	modelBytes, err := json.Marshal(helpTestAppConfig().HelpModel())
+	if err != nil {
+		t.Fatalf("unexpected error encoding help model: %s", err)
+	}
+
+	if strings.Contains(string(modelBytes), "secret") {
+		t.Errorf("unexpected sensitive value in help model: %s", modelBytes)
+	}
+}
+
+func helpTestAppConfig() *bconf.AppConfig {
+	appConfig := bconf.NewAppConfig("app", "Application description")
+	_ = appConfig.SetLoaders(&bconf.EnvironmentLoader{})
+
+	_ = appConfig.AddFieldSets(
+		bconf.FSB().Key("help_api").Fields(
+			bconf.FB().Key("host").Type(bconf.String).Description("The host of the API that requests are sent to").
+				Required().Create(),
+			bconf.FB().Key("token").Type(bconf.String).Default("secret").Sensitive().Create(),
+		).Create(),
+		bconf.FSB().Key("help_log").Fields(
+			bconf.FB().Key("level").Type(bconf.String).Description("Niveau de journalisation pour événements créés").
+				Enumeration("debug", "info").Default("info").Create(),
+			bconf.FB().Key("format").Type(bconf.String).LoadConditions(bconf.IsSet("", "level")).Create(),
+		).Create(),
+	)
+
+	return appConfig
+}
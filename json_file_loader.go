@@ -1,13 +1,17 @@
 package bconf
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"strings"
 )
 
-// type JSONMarshal func(v interface{}) ([]byte, error)
+type JSONMarshal func(v interface{}) ([]byte, error)
 
 type JSONUnmarshal func(data []byte, v interface{}) error
 
@@ -29,7 +33,10 @@ func NewJSONFileLoaderWithAttributes(decoder JSONUnmarshal, filePaths ...string)
 type JSONFileLoader struct {
 	Decoder   JSONUnmarshal
 	FilePaths []string
-	// Encoder   JSONMarshal
+	// Encoder is used by AppConfig.Export to encode JSON, defaulting to indented encoding/json output
+	Encoder JSONMarshal
+	// Strict reports JSON attributes that do not map to a field
+	Strict bool
 }
 
 func (l *JSONFileLoader) Clone() *JSONFileLoader {
@@ -82,6 +89,73 @@ func (l *JSONFileLoader) HelpString(fieldSetKey, fieldKey string) string {
 	return fmt.Sprintf("JSON attribute: %s.%s", fieldSetKey, fieldKey)
 }
 
+func (l *JSONFileLoader) SourceKey(fieldSetKey, fieldKey string) string {
+	return fmt.Sprintf("%s.%s", fieldSetKey, fieldKey)
+}
+
+// SourceKeys returns the attribute paths found in the loader files, e.g. 'log.level' for field-set attributes, and
+// 'strange_key' for top-level attributes that are not field-set objects.
+func (l *JSONFileLoader) SourceKeys() []string {
+	keys := []string{}
+	seen := map[string]struct{}{}
+
+	addKey := func(key string) {
+		if _, found := seen[key]; found {
+			return
+		}
+
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+
+	for _, fileMap := range l.fileMaps() {
+		for fieldSetKey, fieldSetAny := range fileMap {
+			fieldSetMap, ok := fieldSetAny.(map[string]any)
+			if !ok {
+				addKey(fieldSetKey)
+				continue
+			}
+
+			for fieldKey := range fieldSetMap {
+				addKey(l.SourceKey(fieldSetKey, fieldKey))
+			}
+		}
+	}
+
+	return keys
+}
+
+func (l *JSONFileLoader) StrictMode() bool {
+	return l.Strict
+}
+
+// Fingerprint returns a digest of the modification time, size, and contents of every loader file. Missing files are
+// included in the digest, so that creating or removing a file is detected as a change.
+func (l *JSONFileLoader) Fingerprint() (string, error) {
+	hash := sha256.New()
+
+	for _, path := range l.FilePaths {
+		fileInfo, err := os.Stat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			fmt.Fprintf(hash, "%s:missing;", path)
+			continue
+		} else if err != nil {
+			return "", fmt.Errorf("problem checking file '%s': %w", path, err)
+		}
+
+		fileBytes, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("problem reading file '%s': %w", path, err)
+		}
+
+		fmt.Fprintf(hash, "%s:%d:%d:", path, fileInfo.ModTime().UnixNano(), fileInfo.Size())
+		hash.Write(fileBytes)
+		hash.Write([]byte(";"))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func (l *JSONFileLoader) findValueInMaps(fieldSetKey, fieldKey string, maps *[]map[string]any) (string, bool) {
 	if maps == nil {
 		return "", false
@@ -128,6 +128,23 @@ func TestJSONFileLoaderHelpString(t *testing.T) {
 	}
 }
 
+func TestJSONFileLoaderSourceKeys(t *testing.T) {
+	loader := loaderWithTestFixture01()
+
+	if sourceKey := loader.SourceKey("app", "id"); sourceKey != "app.id" {
+		t.Errorf("unexpected source key: '%s'", sourceKey)
+	}
+
+	sourceKeys := loader.SourceKeys()
+	if len(sourceKeys) != 8 {
+		t.Fatalf("unexpected source keys length '%d', expected '8': %v", len(sourceKeys), sourceKeys)
+	}
+
+	if sourceKeys := loaderWithInvalidFilePaths().SourceKeys(); len(sourceKeys) != 0 {
+		t.Errorf("unexpected source keys for loader with invalid file paths: %v", sourceKeys)
+	}
+}
+
 func loaderWithTestFixture01() *bconf.JSONFileLoader {
 	return bconf.NewJSONFileLoaderWithAttributes(json.Unmarshal, "./fixtures/json_config_test_fixture_01.json")
 }
@@ -153,3 +170,20 @@ func loaderWithNoFilePaths() *bconf.JSONFileLoader {
 func loaderWithInvalidFilePaths() *bconf.JSONFileLoader {
 	return bconf.NewJSONFileLoaderWithAttributes(nil, "./fixtures/non-existent-file.json")
 }
+
+func TestJSONFileLoaderFingerprint(t *testing.T) {
+	loader := loaderWithTestFixture01()
+
+	fingerprint, err := loader.Fingerprint()
+	if err != nil {
+		t.Fatalf("unexpected fingerprint error: %s", err)
+	}
+
+	if clonedFingerprint, _ := loader.Clone().Fingerprint(); clonedFingerprint != fingerprint {
+		t.Errorf("unexpected fingerprint mismatch for unchanged files")
+	}
+
+	if missingFingerprint, err := loaderWithInvalidFilePaths().Fingerprint(); err != nil || missingFingerprint == fingerprint {
+		t.Errorf("unexpected fingerprint for missing file: '%s', %v", missingFingerprint, err)
+	}
+}
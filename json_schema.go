@@ -0,0 +1,182 @@
+package bconf
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// durationPattern matches the duration strings parsed by time.ParseDuration.
+const durationPattern = `^[-+]?(0|([0-9]*(\.[0-9]*)?(ns|us|µs|ms|s|m|h))+)$`
+
+// JSONSchema generates a JSON Schema (Draft 2020-12) describing the configuration file shape read by JSONFileLoader,
+// with field-sets as top-level objects. Fields are required when they are required regardless of load conditions and
+// profiles, Sensitive fields are annotated as writeOnly, and unknown attributes are rejected when strict mode is
+// enabled for the app-config or a JSONFileLoader.
+func (c *AppConfig) JSONSchema() ([]byte, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	strict := c.strict
+
+	for _, loader := range c.loaders {
+		if jsonFileLoader, ok := loader.(*JSONFileLoader); ok && jsonFileLoader.Strict {
+			strict = true
+		}
+	}
+
+	fieldSetSchemas := map[string]map[string]any{}
+	requiredFieldSets := []string{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		fieldProperties := map[string]any{}
+		fieldSetSchema := map[string]any{
+			"type":       "object",
+			"properties": fieldProperties,
+		}
+
+		if strict {
+			fieldSetSchema["additionalProperties"] = false
+		}
+
+		unconditional := len(fieldSet.LoadConditions) < 1 && len(fieldSet.Profiles) < 1
+		requiredFields := []string{}
+
+		for _, field := range fieldSet.orderedFields() {
+			fieldProperties[field.Key] = fieldJSONSchema(field)
+
+			if unconditional && field.Required && len(field.LoadConditions) < 1 {
+				requiredFields = append(requiredFields, field.Key)
+			}
+		}
+
+		if len(requiredFields) > 0 {
+			fieldSetSchema["required"] = requiredFields
+			requiredFieldSets = append(requiredFieldSets, fieldSet.Key)
+		}
+
+		fieldSetSchemas[fieldSet.Key] = fieldSetSchema
+	}
+
+	c.addAliasJSONSchemas(fieldSetSchemas)
+
+	properties := make(map[string]any, len(fieldSetSchemas))
+	for key, fieldSetSchema := range fieldSetSchemas {
+		properties[key] = fieldSetSchema
+	}
+
+	schema := map[string]any{
+		"$schema":    jsonSchemaDraft,
+		"type":       "object",
+		"properties": properties,
+	}
+
+	if c.appName != "" {
+		schema["title"] = c.appName
+	}
+
+	if c.appDescription != "" {
+		schema["description"] = c.appDescription
+	}
+
+	if len(requiredFieldSets) > 0 {
+		schema["required"] = requiredFieldSets
+	}
+
+	if strict {
+		schema["additionalProperties"] = false
+	}
+
+	schemaBytes, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("problem encoding json schema: %w", err)
+	}
+
+	return schemaBytes, nil
+}
+
+// -- Private methods --
+
+// addAliasJSONSchemas adds deprecated properties for field aliases located in field-sets known to the app-config.
+func (c *AppConfig) addAliasJSONSchemas(fieldSetSchemas map[string]map[string]any) {
+	for _, fieldSet := range c.orderedFieldSets {
+		for _, field := range fieldSet.orderedFields() {
+			for _, alias := range field.Aliases {
+				aliasFieldSetKey, aliasFieldKey := field.aliasLocation(alias, fieldSet.Key)
+
+				aliasFieldSetSchema, found := fieldSetSchemas[aliasFieldSetKey]
+				if !found {
+					continue
+				}
+
+				properties, _ := aliasFieldSetSchema["properties"].(map[string]any)
+				if _, found := properties[aliasFieldKey]; found {
+					continue
+				}
+
+				aliasSchema := fieldJSONSchema(field)
+				aliasSchema["deprecated"] = true
+				aliasSchema["description"] = fmt.Sprintf("Deprecated alias for '%s.%s'", fieldSet.Key, field.Key)
+				properties[aliasFieldKey] = aliasSchema
+			}
+		}
+	}
+}
+
+func fieldJSONSchema(field *Field) map[string]any {
+	schema := jsonSchemaType(field.Type)
+
+	if field.Description != "" {
+		schema["description"] = field.Description
+	}
+
+	if len(field.Enumeration) > 0 {
+		enumeration := make([]any, len(field.Enumeration))
+		for index, value := range field.Enumeration {
+			enumeration[index] = encodableValue(value)
+		}
+
+		if items, ok := schema["items"].(map[string]any); ok {
+			items["enum"] = enumeration
+		} else {
+			schema["enum"] = enumeration
+		}
+	}
+
+	if field.Default != nil && !field.Sensitive {
+		schema["default"] = encodableValue(field.Default)
+	}
+
+	if field.Sensitive {
+		schema["writeOnly"] = true
+	}
+
+	if field.Deprecated {
+		schema["deprecated"] = true
+	}
+
+	return schema
+}
+
+// jsonSchemaType returns the schema for a field type, matching the values JSONFileLoader parses for the type.
+func jsonSchemaType(fieldType string) map[string]any {
+	switch fieldType {
+	case String:
+		return map[string]any{"type": "string"}
+	case Int:
+		return map[string]any{"type": "integer"}
+	case Bool:
+		return map[string]any{"type": "boolean"}
+	case Float:
+		return map[string]any{"type": "number"}
+	case Time:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case Duration:
+		return map[string]any{"type": "string", "pattern": durationPattern}
+	case Strings, Ints, Bools, Floats, Times, Durations:
+		return map[string]any{"type": "array", "items": jsonSchemaType(fieldType[2:])}
+	default:
+		return map[string]any{}
+	}
+}
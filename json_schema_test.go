@@ -0,0 +1,98 @@
+package bconf_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigJSONSchema(t *testing.T) {
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.SetLoaders(&bconf.JSONFileLoader{Strict: true})
+
+	errs := appConfig.AddFieldSets(
+		bconf.FSB().Key("api").Fields(
+			bconf.FB().Key("host").Type(bconf.String).Description("API host").Required().Create(),
+			bconf.FB().Key("port").Type(bconf.Int).Default(8080).Enumeration(8080, 9090).Create(),
+			bconf.FB().Key("timeout").Type(bconf.Duration).Default(5*time.Second).Create(),
+			bconf.FB().Key("tags").Type(bconf.Strings).Aliases("labels").Create(),
+			bconf.FB().Key("token").Type(bconf.String).Default("secret").Sensitive().Create(),
+			bconf.FB().Key("started").Type(bconf.Time).Create(),
+		).Create(),
+		bconf.FSB().Key("optional").LoadConditions(bconf.IsSet("api", "host")).Fields(
+			bconf.FB().Key("value").Type(bconf.Bool).Required().Create(),
+		).Create(),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	schemaBytes, err := appConfig.JSONSchema()
+	if err != nil {
+		t.Fatalf("unexpected error generating json schema: %s", err)
+	}
+
+	type propertySchema struct {
+		Default    any                       `json:"default"`
+		Items      *propertySchema           `json:"items"`
+		Type       string                    `json:"type"`
+		Format     string                    `json:"format"`
+		Pattern    string                    `json:"pattern"`
+		Properties map[string]propertySchema `json:"properties"`
+		Required   []string                  `json:"required"`
+		Enum       []any                     `json:"enum"`
+		WriteOnly  bool                      `json:"writeOnly"`
+		Deprecated bool                      `json:"deprecated"`
+	}
+
+	schema := struct {
+		propertySchema
+		Schema string `json:"$schema"`
+	}{}
+	if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+		t.Fatalf("unexpected error decoding json schema: %s", err)
+	}
+
+	if schema.Schema != "https://json-schema.org/draft/2020-12/schema" {
+		t.Errorf("unexpected schema draft: '%s'", schema.Schema)
+	}
+
+	if len(schema.Required) != 1 || schema.Required[0] != "api" {
+		t.Errorf("unexpected required field-sets: %v", schema.Required)
+	}
+
+	api := schema.Properties["api"]
+	if len(api.Required) != 1 || api.Required[0] != "host" {
+		t.Errorf("unexpected required fields: %v", api.Required)
+	}
+
+	if port := api.Properties["port"]; port.Type != "integer" || len(port.Enum) != 2 || port.Default != float64(8080) {
+		t.Errorf("unexpected port schema: %+v", port)
+	}
+
+	if timeout := api.Properties["timeout"]; timeout.Type != "string" || timeout.Default != "5s" || timeout.Pattern == "" {
+		t.Errorf("unexpected timeout schema: %+v", timeout)
+	}
+
+	if tags := api.Properties["tags"]; tags.Type != "array" || tags.Items == nil || tags.Items.Type != "string" {
+		t.Errorf("unexpected tags schema: %+v", tags)
+	}
+
+	if labels := api.Properties["labels"]; !labels.Deprecated || labels.Type != "array" {
+		t.Errorf("unexpected alias schema: %+v", labels)
+	}
+
+	if token := api.Properties["token"]; !token.WriteOnly || token.Default != nil {
+		t.Errorf("unexpected sensitive field schema: %+v", token)
+	}
+
+	if started := api.Properties["started"]; started.Format != "date-time" {
+		t.Errorf("unexpected time field schema: %+v", started)
+	}
+
+	if optional := schema.Properties["optional"]; len(optional.Required) != 0 {
+		t.Errorf("unexpected required fields for conditionally loaded field-set: %v", optional.Required)
+	}
+}
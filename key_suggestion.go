@@ -0,0 +1,83 @@
+package bconf
+
+import "strings"
+
+// closestKey returns the candidate most similar to key, or an empty string when no candidate is similar enough to be
+// a likely misspelling.
+func closestKey(key string, candidates []string) string {
+	closest := ""
+	closestDistance := -1
+	normalizedKey := strings.ToLower(key)
+
+	for _, candidate := range candidates {
+		distance := editDistance(normalizedKey, strings.ToLower(candidate))
+		if distance > maxSuggestionDistance(candidate) {
+			continue
+		}
+
+		if closestDistance < 0 || distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+
+	return closest
+}
+
+func maxSuggestionDistance(candidate string) int {
+	if distance := len(candidate) / 4; distance > 1 {
+		return distance
+	}
+
+	return 1
+}
+
+// editDistance returns the optimal string alignment distance between a and b, which counts insertions, deletions,
+// substitutions, and transpositions of adjacent characters as single edits.
+func editDistance(a, b string) int {
+	aRunes := []rune(a)
+	bRunes := []rune(b)
+
+	distances := make([][]int, len(aRunes)+1)
+	for i := range distances {
+		distances[i] = make([]int, len(bRunes)+1)
+		distances[i][0] = i
+	}
+
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+
+	for i := 1; i <= len(aRunes); i++ {
+		for j := 1; j <= len(bRunes); j++ {
+			cost := 1
+			if aRunes[i-1] == bRunes[j-1] {
+				cost = 0
+			}
+
+			distances[i][j] = minInt(
+				distances[i-1][j]+1,
+				distances[i][j-1]+1,
+				distances[i-1][j-1]+cost,
+			)
+
+			if i > 1 && j > 1 && aRunes[i-1] == bRunes[j-2] && aRunes[i-2] == bRunes[j-1] {
+				distances[i][j] = minInt(distances[i][j], distances[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return distances[len(aRunes)][len(bRunes)]
+}
+
+func minInt(values ...int) int {
+	minimum := values[0]
+
+	for _, value := range values[1:] {
+		if value < minimum {
+			minimum = value
+		}
+	}
+
+	return minimum
+}
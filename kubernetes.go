@@ -0,0 +1,188 @@
+package bconf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// KubernetesOptions defines the names and values used by AppConfig.GenerateKubernetesManifests.
+type KubernetesOptions struct {
+	// Name is the ConfigMap and Secret name (default: the app name)
+	Name string
+	// Namespace is the ConfigMap and Secret namespace, omitted when empty
+	Namespace string
+	// IncludeDefaults includes values from defaults in the ConfigMap, rather than only values from loaders and
+	// overrides
+	IncludeDefaults bool
+}
+
+// KubernetesManifests contains YAML generated by AppConfig.GenerateKubernetesManifests.
+type KubernetesManifests struct {
+	// ConfigMap is a ConfigMap manifest with the values of non-sensitive fields
+	ConfigMap []byte
+	// Secret is a Secret manifest with placeholders for Sensitive fields, nil when there are no Sensitive fields
+	Secret []byte
+	// EnvFrom is a container 'envFrom' snippet referencing the ConfigMap and Secret
+	EnvFrom []byte
+	// Env is a container 'env' snippet with an entry referencing the ConfigMap or Secret for every variable
+	Env []byte
+}
+
+type kubernetesEnvEntry struct {
+	key       string
+	value     string
+	sensitive bool
+}
+
+// GenerateKubernetesManifests generates a ConfigMap for the values of non-sensitive fields, a Secret with placeholders
+// for Sensitive fields, and container env snippets referencing them, keyed by EnvironmentLoader variable names. The key
+// prefix is taken from the app-config EnvironmentLoader when present.
+func (c *AppConfig) GenerateKubernetesManifests(options KubernetesOptions) (*KubernetesManifests, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	name := options.Name
+	if name == "" {
+		name = kubernetesName(c.appName)
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("kubernetes manifests require a name when the app name is empty")
+	}
+
+	entries := c.kubernetesEnvEntries(options)
+	configMapEntries := []kubernetesEnvEntry{}
+	secretEntries := []kubernetesEnvEntry{}
+
+	for _, entry := range entries {
+		if entry.sensitive {
+			secretEntries = append(secretEntries, entry)
+		} else {
+			configMapEntries = append(configMapEntries, entry)
+		}
+	}
+
+	manifests := &KubernetesManifests{
+		ConfigMap: kubernetesManifest("ConfigMap", "data", name, options.Namespace, configMapEntries),
+	}
+
+	envFrom := strings.Builder{}
+	envFrom.WriteString("envFrom:\n")
+	envFrom.WriteString(fmt.Sprintf("  - configMapRef:\n      name: %s\n", name))
+
+	if len(secretEntries) > 0 {
+		manifests.Secret = kubernetesManifest("Secret", "stringData", name, options.Namespace, secretEntries)
+		envFrom.WriteString(fmt.Sprintf("  - secretRef:\n      name: %s\n", name))
+	}
+
+	manifests.EnvFrom = []byte(envFrom.String())
+
+	env := strings.Builder{}
+	env.WriteString("env:\n")
+
+	for _, entry := range entries {
+		keyRef := "configMapKeyRef"
+		if entry.sensitive {
+			keyRef = "secretKeyRef"
+		}
+
+		env.WriteString(fmt.Sprintf("  - name: %s\n", entry.key))
+		env.WriteString(fmt.Sprintf("    valueFrom:\n      %s:\n", keyRef))
+		env.WriteString(fmt.Sprintf("        name: %s\n        key: %s\n", name, entry.key))
+	}
+
+	manifests.Env = []byte(env.String())
+
+	return manifests, nil
+}
+
+// -- Private methods --
+
+// kubernetesEnvEntries returns an entry for every Sensitive field, and for every non-sensitive field with a value, in
+// the order field-sets and fields were added.
+func (c *AppConfig) kubernetesEnvEntries(options KubernetesOptions) []kubernetesEnvEntry {
+	loader := &EnvironmentLoader{}
+
+	for _, appConfigLoader := range c.loaders {
+		if environmentLoader, ok := appConfigLoader.(*EnvironmentLoader); ok {
+			loader = environmentLoader
+		}
+	}
+
+	entries := []kubernetesEnvEntry{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		for _, field := range fieldSet.orderedFields() {
+			entry := kubernetesEnvEntry{key: loader.SourceKey(fieldSet.Key, field.Key), sensitive: field.Sensitive}
+
+			if field.Sensitive {
+				entry.value = sensitiveValuePlaceholder
+				entries = append(entries, entry)
+
+				continue
+			}
+
+			value, err := field.getValue()
+			if err != nil {
+				continue
+			}
+
+			switch field.getValueSource() {
+			case ValueSourceDefault, ValueSourceProfileDefault, ValueSourceGeneratedDefault:
+				if !options.IncludeDefaults {
+					continue
+				}
+			}
+
+			entry.value = field.formatValue(value)
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func kubernetesManifest(kind, dataKey, name, namespace string, entries []kubernetesEnvEntry) []byte {
+	builder := strings.Builder{}
+	builder.WriteString("apiVersion: v1\n")
+	builder.WriteString(fmt.Sprintf("kind: %s\n", kind))
+	builder.WriteString(fmt.Sprintf("metadata:\n  name: %s\n", name))
+
+	if namespace != "" {
+		builder.WriteString(fmt.Sprintf("  namespace: %s\n", namespace))
+	}
+
+	if kind == "Secret" {
+		builder.WriteString("type: Opaque\n")
+	}
+
+	if len(entries) < 1 {
+		builder.WriteString(fmt.Sprintf("%s: {}\n", dataKey))
+
+		return []byte(builder.String())
+	}
+
+	builder.WriteString(fmt.Sprintf("%s:\n", dataKey))
+
+	for _, entry := range entries {
+		// ConfigMap and Secret values must be strings, so every value is written as a double-quoted YAML scalar
+		builder.WriteString(fmt.Sprintf("  %s: %s\n", entry.key, strconv.Quote(entry.value)))
+	}
+
+	return []byte(builder.String())
+}
+
+// kubernetesName returns the app name as a Kubernetes resource name, lowercased with unsupported characters replaced.
+func kubernetesName(appName string) string {
+	name := strings.Map(func(character rune) rune {
+		switch {
+		case character >= 'a' && character <= 'z', character >= '0' && character <= '9', character == '-', character == '.':
+			return character
+		default:
+			return '-'
+		}
+	}, strings.ToLower(appName))
+
+	return strings.Trim(name, "-.")
+}
@@ -0,0 +1,72 @@
+package bconf_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigGenerateKubernetesManifests(t *testing.T) {
+	appConfig := bconf.NewAppConfig("My_App", "description")
+	_ = appConfig.SetLoaders(&bconf.EnvironmentLoader{KeyPrefix: "svc"})
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("k8s_api").Fields(
+		bconf.FB().Key("host").Type(bconf.String).Default("localhost").Create(),
+		bconf.FB().Key("port").Type(bconf.Int).Default(8080).Create(),
+		bconf.FB().Key("token").Type(bconf.String).Default("secret").Sensitive().Create(),
+	).Create())
+
+	t.Setenv("SVC_K8S_API_HOST", "api.example.com")
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	manifests, err := appConfig.GenerateKubernetesManifests(bconf.KubernetesOptions{Namespace: "prod"})
+	if err != nil {
+		t.Fatalf("unexpected error generating kubernetes manifests: %s", err)
+	}
+
+	expectedConfigMap := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-app\n  namespace: prod\n" +
+		"data:\n  SVC_K8S_API_HOST: \"api.example.com\"\n"
+	if string(manifests.ConfigMap) != expectedConfigMap {
+		t.Errorf("unexpected config map:\n%s", manifests.ConfigMap)
+	}
+
+	expectedSecret := "apiVersion: v1\nkind: Secret\nmetadata:\n  name: my-app\n  namespace: prod\ntype: Opaque\n" +
+		"stringData:\n  SVC_K8S_API_TOKEN: \"<sensitive-value>\"\n"
+	if string(manifests.Secret) != expectedSecret {
+		t.Errorf("unexpected secret:\n%s", manifests.Secret)
+	}
+
+	if strings.Contains(string(manifests.Secret), "secret\"") {
+		t.Errorf("unexpected sensitive value in secret:\n%s", manifests.Secret)
+	}
+
+	expectedEnvFrom := "envFrom:\n  - configMapRef:\n      name: my-app\n  - secretRef:\n      name: my-app\n"
+	if string(manifests.EnvFrom) != expectedEnvFrom {
+		t.Errorf("unexpected envFrom snippet:\n%s", manifests.EnvFrom)
+	}
+
+	for _, expected := range []string{
+		"  - name: SVC_K8S_API_HOST\n    valueFrom:\n      configMapKeyRef:\n" +
+			"        name: my-app\n        key: SVC_K8S_API_HOST\n",
+		"  - name: SVC_K8S_API_TOKEN\n    valueFrom:\n      secretKeyRef:\n" +
+			"        name: my-app\n        key: SVC_K8S_API_TOKEN\n",
+	} {
+		if !strings.Contains(string(manifests.Env), expected) {
+			t.Errorf("expected env snippet to contain '%s':\n%s", expected, manifests.Env)
+		}
+	}
+
+	manifests, err = appConfig.GenerateKubernetesManifests(bconf.KubernetesOptions{Name: "api", IncludeDefaults: true})
+	if err != nil {
+		t.Fatalf("unexpected error generating kubernetes manifests: %s", err)
+	}
+
+	if !strings.Contains(string(manifests.ConfigMap), "  SVC_K8S_API_PORT: \"8080\"\n") ||
+		!strings.Contains(string(manifests.ConfigMap), "  name: api\n") {
+		t.Errorf("unexpected config map with defaults:\n%s", manifests.ConfigMap)
+	}
+}
@@ -1,5 +1,7 @@
 package bconf
 
+import "fmt"
+
 type LoadConditions []LoadCondition
 
 type LoadCondition interface {
@@ -8,3 +10,60 @@ type LoadCondition interface {
 	Load(value any) (bool, error)
 	Validate() []error
 }
+
+// FieldDependency identifies a field whose value a load condition depends on. An empty FieldSetKey refers to the
+// field-set of the field the load condition is defined on.
+type FieldDependency struct {
+	FieldSetKey string
+	FieldKey    string
+}
+
+type FieldDependencies []FieldDependency
+
+// MultiFieldLoadCondition is a LoadCondition that depends on the values of any number of fields.
+type MultiFieldLoadCondition interface {
+	LoadCondition
+	// FieldDependencies returns every field the load condition depends on
+	FieldDependencies() FieldDependencies
+	// LoadValues returns the load condition outcome, where fields without a value are missing from the values map
+	LoadValues(values map[FieldDependency]any) (bool, error)
+}
+
+// DescribedLoadCondition is a LoadCondition that can describe when it is met, e.g. "log.format == 'console'".
+type DescribedLoadCondition interface {
+	LoadCondition
+	Description() string
+}
+
+// loadConditionDependencies returns the fields a load condition depends on.
+func loadConditionDependencies(loadCondition LoadCondition) FieldDependencies {
+	if multiFieldCondition, ok := loadCondition.(MultiFieldLoadCondition); ok {
+		return multiFieldCondition.FieldDependencies()
+	}
+
+	fieldSetKey, fieldKey := loadCondition.FieldDependency()
+	if fieldSetKey == "" && fieldKey == "" {
+		return FieldDependencies{}
+	}
+
+	return FieldDependencies{{FieldSetKey: fieldSetKey, FieldKey: fieldKey}}
+}
+
+// loadConditionValuesOutcome returns the outcome of a load condition given a map of field dependency values.
+func loadConditionValuesOutcome(loadCondition LoadCondition, values map[FieldDependency]any) (bool, error) {
+	if multiFieldCondition, ok := loadCondition.(MultiFieldLoadCondition); ok {
+		return multiFieldCondition.LoadValues(values)
+	}
+
+	fieldSetKey, fieldKey := loadCondition.FieldDependency()
+	if fieldSetKey == "" && fieldKey == "" {
+		return loadCondition.Load(nil)
+	}
+
+	value, found := values[FieldDependency{FieldSetKey: fieldSetKey, FieldKey: fieldKey}]
+	if !found {
+		return false, fmt.Errorf("no value set for field '%s'", fieldKey)
+	}
+
+	return loadCondition.Load(value)
+}
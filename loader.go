@@ -1,5 +1,7 @@
 package bconf
 
+import "context"
+
 type Loader interface {
 	CloneLoader() Loader
 	Name() string
@@ -8,6 +10,33 @@ type Loader interface {
 	HelpString(fieldSetKey, fieldKey string) string
 }
 
+// SourceKeyLoader is implemented by loaders that can list the keys present in their source, which allows an AppConfig
+// in strict mode to report source keys that do not map to any registered field.
+type SourceKeyLoader interface {
+	Loader
+	// SourceKey returns the key used to look up a field value in the loader source
+	SourceKey(fieldSetKey, fieldKey string) string
+	// SourceKeys returns every key present in the loader source under the loader namespace
+	SourceKeys() []string
+	// StrictMode returns whether unknown source keys are reported for the loader regardless of the AppConfig setting
+	StrictMode() bool
+}
+
+// PollingLoader is implemented by loaders with sources that can change while an application is running, and that can
+// be checked for changes by AppConfig.Watch at each poll interval.
+type PollingLoader interface {
+	Loader
+	// Fingerprint returns a value that changes whenever the loader source changes
+	Fingerprint() (string, error)
+}
+
+// NotifyingLoader is implemented by loaders that push source changes to AppConfig.Watch.
+type NotifyingLoader interface {
+	Loader
+	// Changes returns a channel that receives a value whenever the loader source changes, until the context is done
+	Changes(ctx context.Context) (<-chan struct{}, error)
+}
+
 type LoaderKeyOverride struct {
 	LoaderName     string
 	KeyOverride    string
@@ -0,0 +1,169 @@
+package bconf
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	logicalAnd = "and"
+	logicalOr  = "or"
+	logicalNot = "not"
+)
+
+// And creates a load condition met when all conditions are met.
+func And(conditions ...LoadCondition) LoadCondition {
+	return &LogicalCondition{operator: logicalAnd, conditions: conditions}
+}
+
+// Or creates a load condition met when at least one of the conditions is met.
+func Or(conditions ...LoadCondition) LoadCondition {
+	return &LogicalCondition{operator: logicalOr, conditions: conditions}
+}
+
+// Not creates a load condition met when condition is not met.
+func Not(condition LoadCondition) LoadCondition {
+	return &LogicalCondition{operator: logicalNot, conditions: LoadConditions{condition}}
+}
+
+// LogicalCondition is a load condition combining other load conditions, created with the And, Or, and Not functions.
+type LogicalCondition struct {
+	operator   string
+	conditions LoadConditions
+}
+
+func (c *LogicalCondition) Clone() LoadCondition {
+	clone := *c
+
+	clone.conditions = make(LoadConditions, len(c.conditions))
+	for idx, condition := range c.conditions {
+		if condition != nil {
+			clone.conditions[idx] = condition.Clone()
+		}
+	}
+
+	return &clone
+}
+
+// FieldDependency returns the first field dependency of the combined conditions, use FieldDependencies to get all of
+// the field dependencies.
+func (c *LogicalCondition) FieldDependency() (fieldSetKey, fieldKey string) {
+	dependencies := c.FieldDependencies()
+	if len(dependencies) < 1 {
+		return "", ""
+	}
+
+	return dependencies[0].FieldSetKey, dependencies[0].FieldKey
+}
+
+func (c *LogicalCondition) FieldDependencies() FieldDependencies {
+	dependencies := FieldDependencies{}
+	seen := map[FieldDependency]struct{}{}
+
+	for _, condition := range c.conditions {
+		if condition == nil {
+			continue
+		}
+
+		for _, dependency := range loadConditionDependencies(condition) {
+			if _, found := seen[dependency]; found {
+				continue
+			}
+
+			seen[dependency] = struct{}{}
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	return dependencies
+}
+
+// Load returns the outcome of the combined conditions when they depend on at most one field, use LoadValues for
+// conditions with multiple field dependencies.
+func (c *LogicalCondition) Load(value any) (bool, error) {
+	values := map[FieldDependency]any{}
+
+	dependencies := c.FieldDependencies()
+	if len(dependencies) > 1 {
+		return false, fmt.Errorf("multiple field dependencies found, expected at most one")
+	}
+
+	if len(dependencies) == 1 && value != nil {
+		values[dependencies[0]] = value
+	}
+
+	return c.LoadValues(values)
+}
+
+func (c *LogicalCondition) LoadValues(values map[FieldDependency]any) (bool, error) {
+	switch c.operator {
+	case logicalAnd:
+		for _, condition := range c.conditions {
+			if load, err := loadConditionValuesOutcome(condition, values); err != nil || !load {
+				return false, err
+			}
+		}
+
+		return true, nil
+	case logicalOr:
+		for _, condition := range c.conditions {
+			if load, err := loadConditionValuesOutcome(condition, values); err != nil || load {
+				return load, err
+			}
+		}
+
+		return false, nil
+	case logicalNot:
+		load, err := loadConditionValuesOutcome(c.conditions[0], values)
+
+		return !load && err == nil, err
+	default:
+		return false, fmt.Errorf("unsupported logical operator: '%s'", c.operator)
+	}
+}
+
+func (c *LogicalCondition) Description() string {
+	descriptions := make([]string, len(c.conditions))
+
+	for idx, condition := range c.conditions {
+		descriptions[idx] = "<custom-load-condition-function>"
+
+		if describedCondition, ok := condition.(DescribedLoadCondition); ok {
+			descriptions[idx] = describedCondition.Description()
+		}
+
+		if _, ok := condition.(*LogicalCondition); ok {
+			descriptions[idx] = fmt.Sprintf("(%s)", descriptions[idx])
+		}
+	}
+
+	if c.operator == logicalNot {
+		return fmt.Sprintf("not %s", strings.Join(descriptions, ""))
+	}
+
+	return strings.Join(descriptions, fmt.Sprintf(" %s ", c.operator))
+}
+
+func (c *LogicalCondition) Validate() []error {
+	errs := []error{}
+
+	switch {
+	case c.operator == logicalNot && len(c.conditions) != 1:
+		errs = append(errs, fmt.Errorf("exactly one condition required for '%s' condition", c.operator))
+	case len(c.conditions) < 1:
+		errs = append(errs, fmt.Errorf("at least one condition required for '%s' condition", c.operator))
+	}
+
+	for _, condition := range c.conditions {
+		if condition == nil {
+			errs = append(errs, fmt.Errorf("unexpected nil condition in '%s' condition", c.operator))
+			continue
+		}
+
+		for _, err := range condition.Validate() {
+			errs = append(errs, fmt.Errorf("'%s' condition operand: %w", c.operator, err))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,145 @@
+package bconf_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestLogicalConditions(t *testing.T) {
+	formatDependency := bconf.FieldDependency{FieldSetKey: "log", FieldKey: "format"}
+	colorDependency := bconf.FieldDependency{FieldSetKey: "log", FieldKey: "color_enabled"}
+
+	consoleWithColor := map[bconf.FieldDependency]any{formatDependency: "console", colorDependency: true}
+	consoleWithoutColor := map[bconf.FieldDependency]any{formatDependency: "console", colorDependency: false}
+	json := map[bconf.FieldDependency]any{formatDependency: "json", colorDependency: false}
+
+	type logicalConditionTest struct {
+		condition   bconf.LoadCondition
+		values      []map[bconf.FieldDependency]any
+		description string
+		expected    []bool
+	}
+
+	testCases := map[string]logicalConditionTest{
+		"and": {
+			condition: bconf.And(
+				bconf.Equals("log", "format", "console"),
+				bconf.IsTrue("log", "color_enabled"),
+			),
+			description: "log.format == 'console' and log.color_enabled is true",
+			values:      []map[bconf.FieldDependency]any{consoleWithColor, consoleWithoutColor, json},
+			expected:    []bool{true, false, false},
+		},
+		"or": {
+			condition: bconf.Or(
+				bconf.Equals("log", "format", "json"),
+				bconf.IsTrue("log", "color_enabled"),
+			),
+			description: "log.format == 'json' or log.color_enabled is true",
+			values:      []map[bconf.FieldDependency]any{consoleWithColor, consoleWithoutColor, json},
+			expected:    []bool{true, false, true},
+		},
+		"not": {
+			condition: bconf.Not(
+				bconf.And(bconf.Equals("log", "format", "console"), bconf.IsTrue("log", "color_enabled")),
+			),
+			description: "not (log.format == 'console' and log.color_enabled is true)",
+			values:      []map[bconf.FieldDependency]any{consoleWithColor, consoleWithoutColor, json},
+			expected:    []bool{false, true, true},
+		},
+		"with-field-condition": {
+			condition: bconf.And(
+				bconf.FCB().FieldSetKey("log").FieldKey("format").Condition(func(fieldValue any) (bool, error) {
+					return fieldValue == "console", nil
+				}).Create(),
+				bconf.IsTrue("log", "color_enabled"),
+			),
+			description: "<custom-load-condition-function> and log.color_enabled is true",
+			values:      []map[bconf.FieldDependency]any{consoleWithColor, consoleWithoutColor, json},
+			expected:    []bool{true, false, false},
+		},
+	}
+
+	for name, testCase := range testCases {
+		condition := testCase.condition.Clone()
+
+		multiFieldCondition, ok := condition.(bconf.MultiFieldLoadCondition)
+		if !ok {
+			t.Fatalf("%s: expected condition to implement bconf.MultiFieldLoadCondition", name)
+		}
+
+		if dependencies := multiFieldCondition.FieldDependencies(); len(dependencies) != 2 {
+			t.Fatalf("%s: unexpected field dependencies length '%d', expected '2'", name, len(dependencies))
+		}
+
+		if fieldSetKey, fieldKey := condition.FieldDependency(); fieldSetKey != "log" || fieldKey != "format" {
+			t.Errorf("%s: unexpected field dependency '%s.%s'", name, fieldSetKey, fieldKey)
+		}
+
+		describedCondition, ok := condition.(bconf.DescribedLoadCondition)
+		if !ok {
+			t.Fatalf("%s: expected condition to implement bconf.DescribedLoadCondition", name)
+		}
+
+		if description := describedCondition.Description(); description != testCase.description {
+			t.Errorf("%s: unexpected description '%s', expected '%s'", name, description, testCase.description)
+		}
+
+		for idx, values := range testCase.values {
+			load, err := multiFieldCondition.LoadValues(values)
+			if err != nil {
+				t.Errorf("%s: unexpected error loading values %v: %s", name, values, err)
+			}
+
+			if load != testCase.expected[idx] {
+				t.Errorf("%s: unexpected outcome '%v' for values %v", name, load, values)
+			}
+		}
+
+		if _, err := condition.Load("console"); err == nil {
+			t.Errorf("%s: expected error loading a single value for multiple field dependencies", name)
+		}
+
+		if errs := condition.Validate(); len(errs) > 0 {
+			t.Errorf("%s: unexpected validation errors: %v", name, errs)
+		}
+	}
+}
+
+func TestLogicalConditionValidation(t *testing.T) {
+	if errs := bconf.And().Validate(); len(errs) != 1 {
+		t.Errorf("unexpected validation errors length '%d' for empty and condition, expected '1'", len(errs))
+	}
+
+	if errs := bconf.Not(nil).Validate(); len(errs) != 1 {
+		t.Errorf("unexpected validation errors length '%d' for nil not condition, expected '1'", len(errs))
+	}
+
+	errs := bconf.And(bconf.IsTrue("log", "enabled"), bconf.Not(bconf.Matches("log", "format", "[invalid"))).Validate()
+	if len(errs) != 1 {
+		t.Fatalf("unexpected validation errors length '%d' for nested invalid condition, expected '1': %v", len(errs), errs)
+	}
+
+	expectedPrefix := "'and' condition operand: 'not' condition operand: invalid value condition"
+	if !strings.HasPrefix(errs[0].Error(), expectedPrefix) {
+		t.Errorf("unexpected nested validation error: %s", errs[0])
+	}
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("nested_validation_log").Fields(
+		bconf.FB().Key("format").Type(bconf.String).Default("json").Create(),
+	).Create())
+
+	errs = appConfig.AddFieldSet(bconf.FSB().Key("nested_validation_color").LoadConditions(
+		bconf.And(bconf.Not(bconf.Matches("nested_validation_log", "format", "[invalid"))),
+	).Create())
+	if len(errs) != 1 {
+		t.Errorf("unexpected errors length '%d' adding field-set with nested invalid condition: %v", len(errs), errs)
+	}
+
+	if load, err := bconf.Not(bconf.IsSet("log", "file")).Load(nil); err != nil || !load {
+		t.Errorf("unexpected outcome for not condition with unset value: %v (%v)", load, err)
+	}
+}
@@ -0,0 +1,199 @@
+package bconf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ManPageOptions defines the title line attributes of a man page generated by AppConfig.GenerateManPage.
+type ManPageOptions struct {
+	// Date is the man page date, omitted when zero so generated pages are reproducible
+	Date time.Time
+	// Section is the manual section (default: '1')
+	Section string
+	// Source is the source of the program, e.g. the program name and version
+	Source string
+	// Manual is the manual title, e.g. 'User Commands'
+	Manual string
+}
+
+// GenerateManPage generates a man(7) page for the program named by the app name, with a NAME and DESCRIPTION from the
+// app description, an OPTIONS section from the FlagLoader flags, and an ENVIRONMENT section from the EnvironmentLoader
+// keys. Each option and environment variable is described with the field description, type, accepted values, default
+// value, and required status, with defaults from Sensitive fields redacted.
+func (c *AppConfig) GenerateManPage(options ManPageOptions) []byte {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	command := c.appName
+	if command == "" {
+		command = filepath.Base(os.Args[0])
+	}
+
+	if options.Section == "" {
+		options.Section = "1"
+	}
+
+	date := ""
+	if !options.Date.IsZero() {
+		date = options.Date.Format("2006-01-02")
+	}
+
+	var flagLoader *FlagLoader
+
+	var environmentLoader *EnvironmentLoader
+
+	for _, loader := range c.loaders {
+		switch typedLoader := loader.(type) {
+		case *FlagLoader:
+			flagLoader = typedLoader
+		case *EnvironmentLoader:
+			environmentLoader = typedLoader
+		}
+	}
+
+	builder := strings.Builder{}
+
+	builder.WriteString(fmt.Sprintf(
+		".TH %s %s %s %s %s\n",
+		roffQuote(strings.ToUpper(command)),
+		roffQuote(options.Section),
+		roffQuote(date),
+		roffQuote(options.Source),
+		roffQuote(options.Manual),
+	))
+
+	builder.WriteString(".SH NAME\n")
+
+	if c.appDescription != "" {
+		builder.WriteString(fmt.Sprintf("%s \\- %s\n", roffEscape(command), roffText(c.appDescription)))
+	} else {
+		builder.WriteString(fmt.Sprintf("%s\n", roffEscape(command)))
+	}
+
+	builder.WriteString(".SH SYNOPSIS\n")
+	builder.WriteString(fmt.Sprintf(".B %s\n", roffEscape(command)))
+
+	if flagLoader != nil {
+		builder.WriteString("[\\fIOPTIONS\\fR]\n")
+	}
+
+	if c.appDescription != "" {
+		builder.WriteString(".SH DESCRIPTION\n")
+		builder.WriteString(fmt.Sprintf("%s\n", roffText(c.appDescription)))
+	}
+
+	if flagLoader != nil {
+		builder.WriteString(".SH OPTIONS\n")
+		c.writeManPageOptions(&builder, flagLoader)
+	}
+
+	if environmentLoader != nil {
+		builder.WriteString(".SH ENVIRONMENT\n")
+
+		for _, fieldSet := range c.orderedFieldSets {
+			for _, field := range fieldSet.orderedFields() {
+				builder.WriteString(".TP\n")
+				builder.WriteString(fmt.Sprintf(
+					"\\fB%s\\fR\n",
+					roffEscape(environmentLoader.SourceKey(fieldSet.Key, field.Key)),
+				))
+				writeManPageFieldDetails(&builder, field)
+			}
+		}
+	}
+
+	return []byte(builder.String())
+}
+
+// -- Private methods --
+
+func (c *AppConfig) writeManPageOptions(builder *strings.Builder, loader *FlagLoader) {
+	for _, fieldSet := range c.orderedFieldSets {
+		for _, field := range fieldSet.orderedFields() {
+			flag := roffEscape("--" + loader.SourceKey(fieldSet.Key, field.Key))
+
+			builder.WriteString(".TP\n")
+
+			if field.Type == Bool {
+				builder.WriteString(fmt.Sprintf("\\fB%s\\fR\n", flag))
+			} else {
+				builder.WriteString(fmt.Sprintf("\\fB%s\\fR=\\fI%s\\fR\n", flag, roffEscape(field.Type)))
+			}
+
+			writeManPageFieldDetails(builder, field)
+		}
+	}
+
+	builder.WriteString(".TP\n")
+	builder.WriteString("\\fB\\-h\\fR, \\fB\\-\\-help\\fR\n")
+	builder.WriteString("Show help and exit.\n")
+
+	if c.handlePrintConfigFlag {
+		builder.WriteString(".TP\n")
+		builder.WriteString(fmt.Sprintf("\\fB%s\\fR[=\\fIFORMAT\\fR]\n", roffEscape("--"+printConfigFlag)))
+		builder.WriteString(
+			"Print the effective configuration as a text table, or as JSON when \\fIFORMAT\\fR is 'json', and exit.\n",
+		)
+	}
+}
+
+// writeManPageFieldDetails writes the description, type, accepted values, default value, and required status of a
+// field as lines of a tagged paragraph.
+func writeManPageFieldDetails(builder *strings.Builder, field *Field) {
+	lines := []string{}
+
+	if field.Description != "" {
+		lines = append(lines, field.Description)
+	}
+
+	lines = append(lines, fmt.Sprintf("Type: %s", field.Type))
+
+	if len(field.Enumeration) > 0 {
+		lines = append(lines, fmt.Sprintf("Accepted values: %s", field.enumerationString()))
+	}
+
+	for _, fieldDefault := range field.displayDefaults() {
+		lines = append(lines, fieldDefault.line())
+	}
+
+	lines = append(lines, templateRequiredMarkers(field)...)
+
+	if note := field.deprecationNote(); note != "" {
+		lines = append(lines, note)
+	}
+
+	for index, line := range lines {
+		if index > 0 {
+			builder.WriteString(".br\n")
+		}
+
+		builder.WriteString(fmt.Sprintf("%s\n", roffText(line)))
+	}
+}
+
+// roffEscape escapes backslashes and hyphens, which roff would otherwise interpret as escapes and hyphenation points.
+func roffEscape(text string) string {
+	return strings.NewReplacer(`\`, `\e`, "-", `\-`).Replace(text)
+}
+
+// roffText escapes prose written on its own lines, protecting lines that start with a control character.
+func roffText(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, `\`, `\e`), "\n")
+
+	for index, line := range lines {
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			lines[index] = `\&` + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// roffQuote returns the text as a quoted macro argument.
+func roffQuote(text string) string {
+	return `"` + strings.NewReplacer(`\`, `\e`, `"`, `\(dq`).Replace(text) + `"`
+}
@@ -0,0 +1,55 @@
+package bconf_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigGenerateManPage(t *testing.T) {
+	appConfig := bconf.NewAppConfig("my-app", "HTTP API for user authentication")
+	_ = appConfig.SetLoaders(&bconf.EnvironmentLoader{KeyPrefix: "ext"}, &bconf.FlagLoader{})
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("man_log").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Description(".Logging level").
+			Enumeration("debug", "info").Default("info").Create(),
+		bconf.FB().Key("color").Type(bconf.Bool).Create(),
+		bconf.FB().Key("secret").Type(bconf.String).Default("hunter2").Sensitive().Create(),
+		bconf.FB().Key("path").Type(bconf.String).Required().Create(),
+		bconf.FB().Key("format").Type(bconf.String).Default("json").ProfileDefault("local", "console").
+			Deprecated().DeprecationMessage("use man_log_encoding").Create(),
+	).Create())
+
+	manPage := string(appConfig.GenerateManPage(bconf.ManPageOptions{
+		Date:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Source: "my-app 1.0",
+	}))
+
+	for _, expected := range []string{
+		".TH \"MY-APP\" \"1\" \"2024-01-02\" \"my-app 1.0\" \"\"\n",
+		".SH NAME\nmy\\-app \\- HTTP API for user authentication\n",
+		".SH SYNOPSIS\n.B my\\-app\n[\\fIOPTIONS\\fR]\n",
+		".SH OPTIONS\n.TP\n\\fB\\-\\-man_log_level\\fR=\\fIstring\\fR\n\\&.Logging level\n.br\nType: string\n.br\n" +
+			"Accepted values: ['debug', 'info']\n.br\nDefault: 'info'\n.br\nOptional\n",
+		".TP\n\\fB\\-\\-man_log_color\\fR\nType: bool\n",
+		"Default: '<sensitive-value>'\n",
+		".TP\n\\fB\\-\\-man_log_path\\fR=\\fIstring\\fR\nType: string\n.br\nRequired\n",
+		"Default: 'json'\n.br\nDefault (local): 'console'\n.br\nOptional\n.br\nDeprecated: use man_log_encoding\n",
+		".TP\n\\fB\\-h\\fR, \\fB\\-\\-help\\fR\n",
+		".SH ENVIRONMENT\n.TP\n\\fBEXT_MAN_LOG_LEVEL\\fR\n",
+	} {
+		if !strings.Contains(manPage, expected) {
+			t.Errorf("expected man page to contain '%s':\n%s", expected, manPage)
+		}
+	}
+
+	if strings.Contains(manPage, "hunter2") {
+		t.Errorf("unexpected sensitive value in man page:\n%s", manPage)
+	}
+
+	if strings.Contains(manPage, "print\\-config") {
+		t.Errorf("unexpected print-config option in man page without print-config flag handling:\n%s", manPage)
+	}
+}
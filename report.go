@@ -0,0 +1,190 @@
+package bconf
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	ReportFormatText = "text"
+	ReportFormatJSON = "json"
+)
+
+const printConfigFlag = "print-config"
+
+// ConfigReportEntry describes the effective configuration of a field, with values from Sensitive fields redacted.
+type ConfigReportEntry struct {
+	Value       any    `json:"value"`
+	Default     any    `json:"default"`
+	FieldSetKey string `json:"field_set"`
+	FieldKey    string `json:"field"`
+	Type        string `json:"type"`
+	// Source is the name of the loader the value was found by, one of the ValueSource constants, or empty when no
+	// value is set
+	Source string `json:"source"`
+	// Status is 'loaded', or describes why the field was skipped
+	Status string `json:"status"`
+}
+
+// ConfigReport returns a report of the effective value, source, and default value of every field in the order they
+// were added, formatted as a text table (ReportFormatText) or JSON (ReportFormatJSON). Values from Sensitive fields are
+// redacted.
+func (c *AppConfig) ConfigReport(format string) (string, error) {
+	entries := c.ConfigReportEntries()
+
+	switch format {
+	case ReportFormatText, "":
+		return configReportText(entries), nil
+	case ReportFormatJSON:
+		reportBytes, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("problem encoding configuration report: %w", err)
+		}
+
+		return string(reportBytes) + "\n", nil
+	default:
+		return "", fmt.Errorf("unsupported configuration report format: '%s'", format)
+	}
+}
+
+// ConfigReportEntries returns the configuration report entries of every field in the order they were added.
+func (c *AppConfig) ConfigReportEntries() []ConfigReportEntry {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	entries := []ConfigReportEntry{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		fieldSetStatus := c.fieldSetReportStatus(fieldSet)
+
+		for _, field := range fieldSet.orderedFields() {
+			entry := ConfigReportEntry{
+				FieldSetKey: fieldSet.Key,
+				FieldKey:    field.Key,
+				Type:        field.Type,
+				Source:      field.getValueSource(),
+				Status:      fieldSetStatus,
+			}
+
+			if value, err := field.getValue(); err == nil {
+				entry.Value = reportValue(value, field.Sensitive)
+			}
+
+			if defaultValue, found := field.getDefaultValue(); found {
+				entry.Default = reportValue(defaultValue, field.Sensitive)
+			}
+
+			if entry.Status == "" {
+				entry.Status = "loaded"
+
+				if load, err := c.shouldLoadField(field, fieldSet.Key); err != nil || !load {
+					entry.Status = "skipped: field load conditions not met"
+				}
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// -- Private methods --
+
+// fieldSetReportStatus returns why a field-set was skipped, or an empty string if the field-set is loaded.
+func (c *AppConfig) fieldSetReportStatus(fieldSet *FieldSet) string {
+	if !fieldSet.loadedInProfile(c.profile) {
+		return fmt.Sprintf("skipped: field-set not loaded in profile '%s'", c.profile)
+	}
+
+	if load, err := c.shouldLoadFieldSet(fieldSet); err != nil || !load {
+		return "skipped: field-set load conditions not met"
+	}
+
+	return ""
+}
+
+func (c *AppConfig) printConfigFlagFormat() (string, bool) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	if !c.handlePrintConfigFlag {
+		return "", false
+	}
+
+	for _, arg := range os.Args[1:] {
+		if arg == "--"+printConfigFlag {
+			return ReportFormatText, true
+		}
+
+		if strings.HasPrefix(arg, "--"+printConfigFlag+"=") {
+			return strings.TrimPrefix(arg, "--"+printConfigFlag+"="), true
+		}
+	}
+
+	return "", false
+}
+
+func (c *AppConfig) printConfigReport(format string, errs []error) {
+	report, err := c.ConfigReport(format)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Print(report)
+
+	for _, err := range errs {
+		fmt.Fprintf(os.Stderr, "configuration error: %s\n", err)
+	}
+
+	if len(errs) > 0 {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func configReportText(entries []ConfigReportEntry) string {
+	builder := strings.Builder{}
+	writer := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, "FIELD\tVALUE\tSOURCE\tDEFAULT\tSTATUS")
+
+	for _, entry := range entries {
+		fmt.Fprintf(
+			writer,
+			"%s_%s\t%s\t%s\t%s\t%s\n",
+			entry.FieldSetKey,
+			entry.FieldKey,
+			reportTextValue(entry.Value),
+			reportTextValue(entry.Source),
+			reportTextValue(entry.Default),
+			entry.Status,
+		)
+	}
+
+	_ = writer.Flush()
+
+	return builder.String()
+}
+
+func reportTextValue(value any) string {
+	if value == nil || value == "" {
+		return "-"
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// reportValue returns a value suitable for reports, redacting sensitive values and formatting durations as strings.
+func reportValue(value any, sensitive bool) any {
+	if sensitive {
+		return sensitiveValuePlaceholder
+	}
+
+	return encodableValue(value)
+}
@@ -0,0 +1,75 @@
+package bconf_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigConfigReport(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	errs := appConfig.AddFieldSets(
+		bconf.FSB().Key("report").Fields(
+			bconf.FB().Key("token").Type(bconf.String).Default("secret-token").Sensitive().Create(),
+			bconf.FB().Key("timeout").Type(bconf.Duration).Default(time.Second).Create(),
+			bconf.FB().Key("enabled").Type(bconf.Bool).Default(false).Create(),
+			bconf.FB().Key("mode").Type(bconf.String).LoadConditions(bconf.IsTrue("", "enabled")).Create(),
+		).Create(),
+		bconf.FSB().Key("report_extra").LoadConditions(bconf.IsTrue("report", "enabled")).Fields(
+			bconf.FB().Key("level").Type(bconf.String).Create(),
+		).Create(),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	t.Setenv("REPORT_TIMEOUT", "5s")
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	report, err := appConfig.ConfigReport(bconf.ReportFormatText)
+	if err != nil {
+		t.Fatalf("unexpected error creating text report: %s", err)
+	}
+
+	if strings.Contains(report, "secret-token") {
+		t.Errorf("unexpected sensitive value in report: %s", report)
+	}
+
+	for _, expected := range []string{
+		"FIELD", "report_timeout", "5s", "bconf_environment", "skipped: field load conditions not met",
+		"skipped: field-set load conditions not met",
+	} {
+		if !strings.Contains(report, expected) {
+			t.Errorf("expected report to contain '%s': %s", expected, report)
+		}
+	}
+
+	jsonReport, err := appConfig.ConfigReport(bconf.ReportFormatJSON)
+	if err != nil {
+		t.Fatalf("unexpected error creating json report: %s", err)
+	}
+
+	entries := []bconf.ConfigReportEntry{}
+	if err := json.Unmarshal([]byte(jsonReport), &entries); err != nil {
+		t.Fatalf("unexpected error decoding json report: %s", err)
+	}
+
+	if len(entries) != 5 {
+		t.Fatalf("unexpected json report entries length '%d', expected '5'", len(entries))
+	}
+
+	if entries[1].Value != "5s" || entries[1].Default != "1s" || entries[1].Source != "bconf_environment" {
+		t.Errorf("unexpected json report entry: %+v", entries[1])
+	}
+
+	if _, err := appConfig.ConfigReport("xml"); err == nil {
+		t.Errorf("expected error creating report with unsupported format")
+	}
+}
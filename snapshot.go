@@ -0,0 +1,252 @@
+package bconf
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Snapshot is an immutable view of AppConfig field values at a point in time. Values read from a snapshot are not
+// affected by later reloads or overrides, and a snapshot is safe for concurrent use.
+type Snapshot struct {
+	fieldSets map[string]map[string]snapshotField
+	profile   string
+	// fieldSetOrder tracks field-set keys in the order they were added
+	fieldSetOrder []string
+}
+
+type snapshotField struct {
+	value     any
+	fieldType string
+	set       bool
+}
+
+// Snapshot returns a new snapshot of the current field values.
+func (c *AppConfig) Snapshot() *Snapshot {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	return c.snapshot()
+}
+
+// CurrentSnapshot returns the snapshot of field values taken after the latest registration, reload, or override. The
+// current snapshot is replaced atomically, so readers never observe a partially applied reload.
+func (c *AppConfig) CurrentSnapshot() *Snapshot {
+	if snapshot := c.currentSnapshot.Load(); snapshot != nil {
+		return snapshot
+	}
+
+	return c.Snapshot()
+}
+
+func (s *Snapshot) Profile() string {
+	return s.profile
+}
+
+func (s *Snapshot) GetFieldSetKeys() []string {
+	keys := make([]string, 0, len(s.fieldSets))
+
+	for key := range s.fieldSets {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func (s *Snapshot) GetString(fieldSetKey, fieldKey string) (string, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, String)
+	if err != nil {
+		return "", err
+	}
+
+	val, _ := fieldValue.(string)
+
+	return val, nil
+}
+
+func (s *Snapshot) GetStrings(fieldSetKey, fieldKey string) ([]string, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Strings)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]string)
+
+	return val, nil
+}
+
+func (s *Snapshot) GetInt(fieldSetKey, fieldKey string) (int, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Int)
+	if err != nil {
+		return 0, err
+	}
+
+	val, _ := fieldValue.(int)
+
+	return val, nil
+}
+
+func (s *Snapshot) GetInts(fieldSetKey, fieldKey string) ([]int, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Ints)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]int)
+
+	return val, nil
+}
+
+func (s *Snapshot) GetBool(fieldSetKey, fieldKey string) (bool, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Bool)
+	if err != nil {
+		return false, err
+	}
+
+	val, _ := fieldValue.(bool)
+
+	return val, nil
+}
+
+func (s *Snapshot) GetBools(fieldSetKey, fieldKey string) ([]bool, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Bools)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]bool)
+
+	return val, nil
+}
+
+func (s *Snapshot) GetTime(fieldSetKey, fieldKey string) (time.Time, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Time)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	val, _ := fieldValue.(time.Time)
+
+	return val, nil
+}
+
+func (s *Snapshot) GetTimes(fieldSetKey, fieldKey string) ([]time.Time, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Times)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]time.Time)
+
+	return val, nil
+}
+
+func (s *Snapshot) GetDuration(fieldSetKey, fieldKey string) (time.Duration, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Duration)
+	if err != nil {
+		return 0, err
+	}
+
+	val, _ := fieldValue.(time.Duration)
+
+	return val, nil
+}
+
+func (s *Snapshot) GetDurations(fieldSetKey, fieldKey string) ([]time.Duration, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Durations)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]time.Duration)
+
+	return val, nil
+}
+
+func (s *Snapshot) FillStruct(configStruct any) error {
+	return fillStruct(configStruct, s.fieldSetOrder, func(fieldSetKey, fieldKey string) (any, bool, error) {
+		field, err := s.getField(fieldSetKey, fieldKey)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return copySliceValue(field.value), field.set, nil
+	})
+}
+
+// -- Private methods --
+
+// snapshot returns a new snapshot of the current field values, and expects the caller to hold the field-set lock.
+func (c *AppConfig) snapshot() *Snapshot {
+	snapshot := &Snapshot{
+		fieldSets:     make(map[string]map[string]snapshotField, len(c.fieldSets)),
+		profile:       c.profile,
+		fieldSetOrder: c.fieldSetKeys(),
+	}
+
+	for fieldSetKey, fieldSet := range c.fieldSets {
+		fields := make(map[string]snapshotField, len(fieldSet.fieldMap))
+
+		for fieldKey, field := range fieldSet.fieldMap {
+			value, err := field.getValue()
+			fields[fieldKey] = snapshotField{
+				value:     copySliceValue(value),
+				fieldType: field.Type,
+				set:       err == nil,
+			}
+		}
+
+		snapshot.fieldSets[fieldSetKey] = fields
+	}
+
+	return snapshot
+}
+
+// refreshCurrentSnapshot replaces the current snapshot, and expects the caller to hold the field-set lock.
+func (c *AppConfig) refreshCurrentSnapshot() {
+	c.currentSnapshot.Store(c.snapshot())
+}
+
+func (s *Snapshot) getField(fieldSetKey, fieldKey string) (snapshotField, error) {
+	fieldSet, found := s.fieldSets[fieldSetKey]
+	if !found {
+		return snapshotField{}, fmt.Errorf("field-set not found with key '%s'", fieldSetKey)
+	}
+
+	field, found := fieldSet[fieldKey]
+	if !found {
+		return snapshotField{}, fmt.Errorf("field-set field not found with key '%s'", fieldKey)
+	}
+
+	return field, nil
+}
+
+func (s *Snapshot) getFieldValue(fieldSetKey, fieldKey, expectedType string) (any, error) {
+	field, err := s.getField(fieldSetKey, fieldKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedType != "" && expectedType != "any" && field.fieldType != expectedType {
+		return nil, fmt.Errorf("incorrect field-type for field '%s', found '%s'", fieldKey, field.fieldType)
+	}
+
+	if !field.set {
+		return nil, fmt.Errorf("no value set for field '%s'", fieldKey)
+	}
+
+	return copySliceValue(field.value), nil
+}
+
+// copySliceValue returns a copy of slice values, so that callers cannot modify values shared with a snapshot.
+func copySliceValue(value any) any {
+	sliceValue := reflect.ValueOf(value)
+	if sliceValue.Kind() != reflect.Slice || sliceValue.IsNil() {
+		return value
+	}
+
+	sliceCopy := reflect.MakeSlice(sliceValue.Type(), sliceValue.Len(), sliceValue.Len())
+	reflect.Copy(sliceCopy, sliceValue)
+
+	return sliceCopy.Interface()
+}
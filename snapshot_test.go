@@ -0,0 +1,121 @@
+package bconf_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigSnapshot(t *testing.T) {
+	type SnapshotConfig struct {
+		bconf.ConfigStruct `bconf:"snapshot"`
+		Hosts              []string      `bconf:"hosts"`
+		Timeout            time.Duration `bconf:"timeout"`
+		Unset              string        `bconf:"unset"`
+	}
+
+	appConfig := createBaseAppConfig()
+
+	errs := appConfig.AddFieldSet(bconf.FSB().Key("snapshot").Fields(
+		bconf.FB().Key("hosts").Type(bconf.Strings).Default([]string{"a", "b"}).Create(),
+		bconf.FB().Key("timeout").Type(bconf.Duration).Default(time.Second).Create(),
+		bconf.FB().Key("unset").Type(bconf.String).Create(),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-set: %v", errs)
+	}
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	snapshot := appConfig.Snapshot()
+	currentSnapshot := appConfig.CurrentSnapshot()
+
+	if err := appConfig.SetField("snapshot", "timeout", 2*time.Second); err != nil {
+		t.Fatalf("unexpected error setting field: %s", err)
+	}
+
+	if val, _ := snapshot.GetDuration("snapshot", "timeout"); val != time.Second {
+		t.Errorf("unexpected snapshot value after override: '%s'", val)
+	}
+
+	if val, _ := currentSnapshot.GetDuration("snapshot", "timeout"); val != time.Second {
+		t.Errorf("unexpected previous current snapshot value after override: '%s'", val)
+	}
+
+	if val, _ := appConfig.CurrentSnapshot().GetDuration("snapshot", "timeout"); val != 2*time.Second {
+		t.Errorf("unexpected current snapshot value after override: '%s'", val)
+	}
+
+	hosts, _ := snapshot.GetStrings("snapshot", "hosts")
+	hosts[0] = "modified"
+
+	if hosts, _ := snapshot.GetStrings("snapshot", "hosts"); hosts[0] != "a" {
+		t.Errorf("unexpected snapshot value modified through returned slice: %v", hosts)
+	}
+
+	if _, err := snapshot.GetString("snapshot", "unset"); err == nil {
+		t.Errorf("expected error getting unset snapshot value")
+	}
+
+	if _, err := snapshot.GetInt("snapshot", "timeout"); err == nil {
+		t.Errorf("expected error getting snapshot value with incorrect type")
+	}
+
+	if _, err := snapshot.GetString("missing", "unset"); err == nil {
+		t.Errorf("expected error getting snapshot value from missing field-set")
+	}
+
+	configStruct := &SnapshotConfig{}
+	if err := snapshot.FillStruct(configStruct); err != nil {
+		t.Fatalf("unexpected error filling struct from snapshot: %s", err)
+	}
+
+	if configStruct.Timeout != time.Second || len(configStruct.Hosts) != 2 || configStruct.Unset != "" {
+		t.Errorf("unexpected struct values filled from snapshot: %+v", configStruct)
+	}
+}
+
+func TestAppConfigCurrentSnapshotConcurrentReads(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("snapshot_pair").Fields(
+		bconf.FB().Key("low").Type(bconf.Int).Default(0).Create(),
+		bconf.FB().Key("high").Type(bconf.Int).Default(1).Create(),
+	).Create())
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		for i := 1; i <= 200; i++ {
+			_ = appConfig.SetField("snapshot_pair", "low", i)
+			_ = appConfig.SetField("snapshot_pair", "high", i+1)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 200; i++ {
+			snapshot := appConfig.CurrentSnapshot()
+			low, _ := snapshot.GetInt("snapshot_pair", "low")
+			high, _ := snapshot.GetInt("snapshot_pair", "high")
+
+			if low != high-1 && low != high {
+				t.Errorf("inconsistent snapshot values: low '%d', high '%d'", low, high)
+			}
+		}
+	}()
+
+	wg.Wait()
+}
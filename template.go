@@ -0,0 +1,340 @@
+package bconf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// TemplateFormatJSONC generates a JSON-with-comments template in the shape read by JSONFileLoader
+	TemplateFormatJSONC = "jsonc"
+	// TemplateFormatYAML generates a YAML template, with field-sets as top-level keys
+	TemplateFormatYAML = "yaml"
+	// TemplateFormatTOML generates a TOML template, with field-sets as tables
+	TemplateFormatTOML = "toml"
+	// TemplateFormatEnv generates a '.env' template matching EnvironmentLoader keys
+	TemplateFormatEnv = "env"
+)
+
+type templateFieldSet struct {
+	key      string
+	comments []string
+	fields   []templateField
+}
+
+type templateField struct {
+	field    *Field
+	value    any
+	comments []string
+	// active identifies fields with a default value, which are written uncommented
+	active bool
+}
+
+// GenerateTemplate generates a commented sample configuration file containing every field-set and field in the order
+// they were added, with field descriptions, types, accepted values, defaults, and required markers. Fields with a
+// default value are set to the default, and fields without one are commented out.
+func (c *AppConfig) GenerateTemplate(format string) ([]byte, error) {
+	c.fieldSetLock.RLock()
+	defer c.fieldSetLock.RUnlock()
+
+	fieldSets := c.templateFieldSets()
+
+	switch format {
+	case TemplateFormatJSONC:
+		return c.jsoncTemplate(fieldSets)
+	case TemplateFormatYAML:
+		return c.yamlTemplate(fieldSets)
+	case TemplateFormatTOML:
+		return c.tomlTemplate(fieldSets)
+	case TemplateFormatEnv:
+		return c.envTemplate(fieldSets), nil
+	default:
+		return nil, fmt.Errorf("unsupported template format: '%s'", format)
+	}
+}
+
+// -- Private methods --
+
+func (c *AppConfig) templateFieldSets() []templateFieldSet {
+	fieldSets := make([]templateFieldSet, 0, len(c.orderedFieldSets))
+
+	for _, fieldSet := range c.orderedFieldSets {
+		templateSet := templateFieldSet{key: fieldSet.Key}
+
+		if len(fieldSet.Profiles) > 0 {
+			templateSet.comments = append(
+				templateSet.comments,
+				fmt.Sprintf("Loaded in profiles: %s", strings.Join(fieldSet.Profiles, ", ")),
+			)
+		}
+
+		for _, condition := range fieldSet.LoadConditions {
+			templateSet.comments = append(templateSet.comments, fmt.Sprintf("Loaded when %s", loadConditionSummary(condition)))
+		}
+
+		for _, field := range fieldSet.orderedFields() {
+			templateSet.fields = append(templateSet.fields, templateFieldFor(field))
+		}
+
+		fieldSets = append(fieldSets, templateSet)
+	}
+
+	return fieldSets
+}
+
+func templateFieldFor(field *Field) templateField {
+	templateField := templateField{field: field}
+
+	if field.Description != "" {
+		templateField.comments = append(templateField.comments, field.Description)
+	}
+
+	templateField.comments = append(templateField.comments, fmt.Sprintf("Type: %s", field.Type))
+
+	if len(field.Enumeration) > 0 {
+		templateField.comments = append(
+			templateField.comments,
+			fmt.Sprintf("Accepted values: %s", field.enumerationString()),
+		)
+	}
+
+	if field.Default != nil && !field.Sensitive {
+		templateField.value = field.Default
+		templateField.active = true
+	}
+
+	for _, fieldDefault := range field.displayDefaults() {
+		templateField.comments = append(templateField.comments, fieldDefault.line())
+	}
+
+	templateField.comments = append(templateField.comments, templateRequiredMarkers(field)...)
+
+	if note := field.deprecationNote(); note != "" {
+		templateField.comments = append(templateField.comments, note)
+	}
+
+	return templateField
+}
+
+func templateRequiredMarkers(field *Field) []string {
+	markers := []string{}
+
+	conditions := make([]string, len(field.LoadConditions))
+	for index, condition := range field.LoadConditions {
+		conditions[index] = loadConditionSummary(condition)
+	}
+
+	switch {
+	case field.Required && len(conditions) > 0:
+		markers = append(markers, fmt.Sprintf("Required when %s", strings.Join(conditions, " and ")))
+	case field.Required:
+		markers = append(markers, "Required")
+	case len(field.RequiredInProfiles) > 0:
+		markers = append(markers, fmt.Sprintf("Required in profiles: %s", strings.Join(field.RequiredInProfiles, ", ")))
+	default:
+		markers = append(markers, "Optional")
+	}
+
+	if !field.Required && len(conditions) > 0 {
+		markers = append(markers, fmt.Sprintf("Loaded when %s", strings.Join(conditions, " and ")))
+	}
+
+	return markers
+}
+
+// loadConditionSummary returns the description of a load condition, or the fields it depends on when the condition
+// is not described.
+func loadConditionSummary(condition LoadCondition) string {
+	if describedCondition, ok := condition.(DescribedLoadCondition); ok {
+		return describedCondition.Description()
+	}
+
+	fieldSetKey, fieldKey := condition.FieldDependency()
+	if fieldSetKey != "" && fieldKey != "" {
+		return fmt.Sprintf("<custom-load-condition-function> depending on '%s_%s'", fieldSetKey, fieldKey)
+	}
+
+	return "<custom-load-condition-function>"
+}
+
+func (c *AppConfig) templateHeader(commentPrefix string) string {
+	builder := strings.Builder{}
+
+	if c.appName != "" {
+		builder.WriteString(fmt.Sprintf("%s Configuration template for '%s'\n", commentPrefix, c.appName))
+	}
+
+	if c.appDescription != "" {
+		builder.WriteString(fmt.Sprintf("%s %s\n", commentPrefix, c.appDescription))
+	}
+
+	return builder.String()
+}
+
+func (c *AppConfig) jsoncTemplate(fieldSets []templateFieldSet) ([]byte, error) {
+	builder := strings.Builder{}
+	builder.WriteString(c.templateHeader("//"))
+	builder.WriteString("{\n")
+
+	for fieldSetIndex, fieldSet := range fieldSets {
+		writeTemplateComments(&builder, "  //", fieldSet.comments)
+		builder.WriteString(fmt.Sprintf("  %q: {\n", fieldSet.key))
+
+		lastActive := -1
+
+		for index, field := range fieldSet.fields {
+			if field.active {
+				lastActive = index
+			}
+		}
+
+		for index, field := range fieldSet.fields {
+			if index > 0 {
+				builder.WriteString("\n")
+			}
+
+			writeTemplateComments(&builder, "    //", field.comments)
+
+			if !field.active {
+				builder.WriteString(fmt.Sprintf("    // %q: <%s>\n", field.field.Key, field.field.Type))
+				continue
+			}
+
+			encoded, err := json.Marshal(encodableValue(field.value))
+			if err != nil {
+				return nil, fmt.Errorf("problem encoding field '%s_%s': %w", fieldSet.key, field.field.Key, err)
+			}
+
+			separator := ","
+			if index == lastActive {
+				separator = ""
+			}
+
+			builder.WriteString(fmt.Sprintf("    %q: %s%s\n", field.field.Key, encoded, separator))
+		}
+
+		if fieldSetIndex < len(fieldSets)-1 {
+			builder.WriteString("  },\n")
+		} else {
+			builder.WriteString("  }\n")
+		}
+	}
+
+	builder.WriteString("}\n")
+
+	return []byte(builder.String()), nil
+}
+
+func (c *AppConfig) yamlTemplate(fieldSets []templateFieldSet) ([]byte, error) {
+	builder := strings.Builder{}
+	builder.WriteString(c.templateHeader("#"))
+
+	for _, fieldSet := range fieldSets {
+		builder.WriteString("\n")
+		writeTemplateComments(&builder, "#", fieldSet.comments)
+		builder.WriteString(fmt.Sprintf("%s:\n", fieldSet.key))
+
+		for index, field := range fieldSet.fields {
+			if index > 0 {
+				builder.WriteString("\n")
+			}
+
+			writeTemplateComments(&builder, "  #", field.comments)
+
+			if !field.active {
+				builder.WriteString(fmt.Sprintf("  # %s: <%s>\n", field.field.Key, field.field.Type))
+				continue
+			}
+
+			encoded, err := json.Marshal(encodableValue(field.value))
+			if err != nil {
+				return nil, fmt.Errorf("problem encoding field '%s_%s': %w", fieldSet.key, field.field.Key, err)
+			}
+
+			builder.WriteString(fmt.Sprintf("  %s: %s\n", field.field.Key, encoded))
+		}
+	}
+
+	return []byte(builder.String()), nil
+}
+
+func (c *AppConfig) tomlTemplate(fieldSets []templateFieldSet) ([]byte, error) {
+	builder := strings.Builder{}
+	builder.WriteString(c.templateHeader("#"))
+
+	for _, fieldSet := range fieldSets {
+		builder.WriteString("\n")
+		writeTemplateComments(&builder, "#", fieldSet.comments)
+		builder.WriteString(fmt.Sprintf("[%s]\n", fieldSet.key))
+
+		for index, field := range fieldSet.fields {
+			if index > 0 {
+				builder.WriteString("\n")
+			}
+
+			writeTemplateComments(&builder, "#", field.comments)
+
+			if !field.active {
+				builder.WriteString(fmt.Sprintf("# %s = <%s>\n", field.field.Key, field.field.Type))
+				continue
+			}
+
+			encoded, err := json.Marshal(encodableValue(field.value))
+			if err != nil {
+				return nil, fmt.Errorf("problem encoding field '%s_%s': %w", fieldSet.key, field.field.Key, err)
+			}
+
+			builder.WriteString(fmt.Sprintf("%s = %s\n", field.field.Key, encoded))
+		}
+	}
+
+	return []byte(builder.String()), nil
+}
+
+func (c *AppConfig) envTemplate(fieldSets []templateFieldSet) []byte {
+	loader := &EnvironmentLoader{}
+
+	for _, appConfigLoader := range c.loaders {
+		if environmentLoader, ok := appConfigLoader.(*EnvironmentLoader); ok {
+			loader = environmentLoader
+		}
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString(c.templateHeader("#"))
+
+	for _, fieldSet := range fieldSets {
+		builder.WriteString("\n")
+		writeTemplateComments(&builder, "#", fieldSet.comments)
+
+		for index, field := range fieldSet.fields {
+			if index > 0 {
+				builder.WriteString("\n")
+			}
+
+			writeTemplateComments(&builder, "#", field.comments)
+
+			key := loader.SourceKey(fieldSet.key, field.field.Key)
+
+			if !field.active {
+				builder.WriteString(fmt.Sprintf("# %s=\n", key))
+				continue
+			}
+
+			builder.WriteString(fmt.Sprintf("%s=%s\n", key, envQuote(field.field.formatValue(field.value))))
+		}
+	}
+
+	return []byte(builder.String())
+}
+
+// writeTemplateComments writes every line of the comments with the comment prefix, so that multi-line comments, e.g.
+// field descriptions, stay comments.
+func writeTemplateComments(builder *strings.Builder, commentPrefix string, comments []string) {
+	for _, comment := range comments {
+		for _, line := range strings.Split(comment, "\n") {
+			builder.WriteString(fmt.Sprintf("%s %s\n", commentPrefix, line))
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package bconf_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigGenerateTemplate(t *testing.T) {
+	appConfig := bconf.NewAppConfig("app", "Application description")
+	_ = appConfig.SetLoaders(&bconf.EnvironmentLoader{KeyPrefix: "svc"})
+
+	errs := appConfig.AddFieldSets(
+		bconf.FSB().Key("log").Fields(
+			bconf.FB().Key("level").Type(bconf.String).Description("Logging level\nfor every logger").
+				Enumeration("debug", "info").Default("info").Create(),
+			bconf.FB().Key("format").Type(bconf.String).Create(),
+			bconf.FB().Key("timeout").Type(bconf.Duration).Default(5*time.Second).Create(),
+		).Create(),
+		bconf.FSB().Key("db").Fields(
+			bconf.FB().Key("enabled").Type(bconf.Bool).Default(false).Create(),
+			bconf.FB().Key("host").Type(bconf.String).Required().
+				LoadConditions(bconf.IsTrue("", "enabled")).Create(),
+			bconf.FB().Key("password").Type(bconf.String).Sensitive().Required().Create(),
+		).Create(),
+	)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-sets: %v", errs)
+	}
+
+	jsoncTemplate, err := appConfig.GenerateTemplate(bconf.TemplateFormatJSONC)
+	if err != nil {
+		t.Fatalf("unexpected error generating jsonc template: %s", err)
+	}
+
+	for _, expected := range []string{
+		"    // Logging level\n    // for every logger\n",
+		"// Accepted values: ['debug', 'info']",
+		"// \"format\": <string>",
+		"// Required when enabled is true",
+		"\"timeout\": \"5s\"",
+	} {
+		if !strings.Contains(string(jsoncTemplate), expected) {
+			t.Errorf("expected jsonc template to contain '%s':\n%s", expected, jsoncTemplate)
+		}
+	}
+
+	if strings.Index(string(jsoncTemplate), "\"log\"") > strings.Index(string(jsoncTemplate), "\"db\"") {
+		t.Errorf("expected jsonc template field-sets in declaration order:\n%s", jsoncTemplate)
+	}
+
+	uncommented := []string{}
+
+	for _, line := range strings.Split(string(jsoncTemplate), "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "//") {
+			uncommented = append(uncommented, line)
+		}
+	}
+
+	templateValues := map[string]map[string]any{}
+	if err := json.Unmarshal([]byte(strings.Join(uncommented, "\n")), &templateValues); err != nil {
+		t.Fatalf("unexpected error decoding jsonc template without comments: %s\n%s", err, jsoncTemplate)
+	}
+
+	if templateValues["log"]["level"] != "info" || len(templateValues["db"]) != 1 {
+		t.Errorf("unexpected jsonc template values: %v", templateValues)
+	}
+
+	expectedTemplates := map[string][]string{
+		bconf.TemplateFormatYAML: {
+			"log:\n", "  # Logging level\n  # for every logger\n", "  # Type: string\n", "  level: \"info\"\n",
+			"  # format: <string>\n",
+		},
+		bconf.TemplateFormatTOML: {
+			"[db]\n", "# Logging level\n# for every logger\n", "enabled = false\n", "# password = <string>\n",
+		},
+		bconf.TemplateFormatEnv: {"SVC_LOG_LEVEL=info\n", "# SVC_DB_PASSWORD=\n", "# Required\n"},
+	}
+
+	for format, expectedContents := range expectedTemplates {
+		template, err := appConfig.GenerateTemplate(format)
+		if err != nil {
+			t.Fatalf("unexpected error generating %s template: %s", format, err)
+		}
+
+		for _, expected := range expectedContents {
+			if !strings.Contains(string(template), expected) {
+				t.Errorf("expected %s template to contain '%s':\n%s", format, expected, template)
+			}
+		}
+	}
+
+	if _, err := appConfig.GenerateTemplate("xml"); err == nil {
+		t.Errorf("expected error generating template with unsupported format")
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+
+package bconf
+
+import "os"
+
+// terminalWidth returns 0, as terminal widths are only detected on unix platforms.
+func terminalWidth(_ *os.File) int {
+	return 0
+}
@@ -0,0 +1,31 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+package bconf
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// terminalWidth returns the column width of the terminal attached to the file, or 0 when the file is not a terminal.
+func terminalWidth(file *os.File) int {
+	windowSize := struct {
+		rows    uint16
+		columns uint16
+		xPixels uint16
+		yPixels uint16
+	}{}
+
+	_, _, errno := syscall.Syscall(
+		syscall.SYS_IOCTL,
+		file.Fd(),
+		uintptr(syscall.TIOCGWINSZ),
+		uintptr(unsafe.Pointer(&windowSize)),
+	)
+	if errno != 0 {
+		return 0
+	}
+
+	return int(windowSize.columns)
+}
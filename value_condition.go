@@ -0,0 +1,188 @@
+package bconf
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Equals creates a load condition met when the dependency field value equals value.
+func Equals(fieldSetKey, fieldKey string, value any) LoadCondition {
+	return newValueCondition(
+		fieldSetKey,
+		fieldKey,
+		fmt.Sprintf("== '%v'", value),
+		func(fieldValue any, found bool) (bool, error) {
+			return found && reflect.DeepEqual(fieldValue, value), nil
+		},
+	)
+}
+
+// NotEquals creates a load condition met when the dependency field value does not equal value, or is not set.
+func NotEquals(fieldSetKey, fieldKey string, value any) LoadCondition {
+	return newValueCondition(
+		fieldSetKey,
+		fieldKey,
+		fmt.Sprintf("!= '%v'", value),
+		func(fieldValue any, found bool) (bool, error) {
+			return !found || !reflect.DeepEqual(fieldValue, value), nil
+		},
+	)
+}
+
+// In creates a load condition met when the dependency field value equals one of values.
+func In(fieldSetKey, fieldKey string, values ...any) LoadCondition {
+	valueStrings := make([]string, len(values))
+	for idx, value := range values {
+		valueStrings[idx] = fmt.Sprintf("'%v'", value)
+	}
+
+	return newValueCondition(
+		fieldSetKey,
+		fieldKey,
+		fmt.Sprintf("in [%s]", strings.Join(valueStrings, ", ")),
+		func(fieldValue any, found bool) (bool, error) {
+			if !found {
+				return false, nil
+			}
+
+			for _, value := range values {
+				if reflect.DeepEqual(fieldValue, value) {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		},
+	)
+}
+
+// IsTrue creates a load condition met when the dependency bool field value is true.
+func IsTrue(fieldSetKey, fieldKey string) LoadCondition {
+	return newValueCondition(
+		fieldSetKey,
+		fieldKey,
+		"is true",
+		func(fieldValue any, found bool) (bool, error) {
+			if !found {
+				return false, nil
+			}
+
+			value, ok := fieldValue.(bool)
+			if !ok {
+				return false, fmt.Errorf("expected bool field value, found '%T'", fieldValue)
+			}
+
+			return value, nil
+		},
+	)
+}
+
+// IsSet creates a load condition met when the dependency field has a value from any source, including defaults.
+func IsSet(fieldSetKey, fieldKey string) LoadCondition {
+	return newValueCondition(
+		fieldSetKey,
+		fieldKey,
+		"is set",
+		func(fieldValue any, found bool) (bool, error) {
+			return found, nil
+		},
+	)
+}
+
+// Matches creates a load condition met when the dependency string field value matches the regular expression pattern.
+func Matches(fieldSetKey, fieldKey, pattern string) LoadCondition {
+	expression, compileErr := regexp.Compile(pattern)
+
+	condition := newValueCondition(
+		fieldSetKey,
+		fieldKey,
+		fmt.Sprintf("matches '%s'", pattern),
+		func(fieldValue any, found bool) (bool, error) {
+			if compileErr != nil {
+				return false, fmt.Errorf("invalid pattern: %w", compileErr)
+			}
+
+			if !found {
+				return false, nil
+			}
+
+			value, ok := fieldValue.(string)
+			if !ok {
+				return false, fmt.Errorf("expected string field value, found '%T'", fieldValue)
+			}
+
+			return expression.MatchString(value), nil
+		},
+	)
+	condition.err = compileErr
+
+	return condition
+}
+
+// ValueCondition is a load condition comparing the value of a single dependency field, created with functions such as
+// Equals, In, or IsSet.
+type ValueCondition struct {
+	check       func(fieldValue any, found bool) (bool, error)
+	err         error
+	dependency  FieldDependency
+	description string
+}
+
+func newValueCondition(
+	fieldSetKey, fieldKey, description string,
+	check func(fieldValue any, found bool) (bool, error),
+) *ValueCondition {
+	return &ValueCondition{
+		check:       check,
+		dependency:  FieldDependency{FieldSetKey: fieldSetKey, FieldKey: fieldKey},
+		description: description,
+	}
+}
+
+func (c *ValueCondition) Clone() LoadCondition {
+	clone := *c
+
+	return &clone
+}
+
+func (c *ValueCondition) FieldDependency() (fieldSetKey, fieldKey string) {
+	return c.dependency.FieldSetKey, c.dependency.FieldKey
+}
+
+func (c *ValueCondition) FieldDependencies() FieldDependencies {
+	return FieldDependencies{c.dependency}
+}
+
+func (c *ValueCondition) Load(value any) (bool, error) {
+	return c.check(value, value != nil)
+}
+
+func (c *ValueCondition) LoadValues(values map[FieldDependency]any) (bool, error) {
+	value, found := values[c.dependency]
+
+	return c.check(value, found)
+}
+
+func (c *ValueCondition) Description() string {
+	if c.dependency.FieldSetKey == "" {
+		return fmt.Sprintf("%s %s", c.dependency.FieldKey, c.description)
+	}
+
+	return fmt.Sprintf("%s.%s %s", c.dependency.FieldSetKey, c.dependency.FieldKey, c.description)
+}
+
+func (c *ValueCondition) Validate() []error {
+	errs := []error{}
+
+	if c.dependency.FieldKey == "" {
+		errs = append(errs, fmt.Errorf("field key required for value condition"))
+	}
+
+	if c.err != nil {
+		errs = append(errs, fmt.Errorf("invalid value condition: %w", c.err))
+	}
+
+	return errs
+}
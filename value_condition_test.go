@@ -0,0 +1,129 @@
+package bconf_test
+
+import (
+	"testing"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestValueConditions(t *testing.T) {
+	type valueConditionTest struct {
+		condition   bconf.LoadCondition
+		values      []any
+		description string
+		expected    []bool
+		unset       bool
+	}
+
+	testCases := map[string]valueConditionTest{
+		"equals": {
+			condition:   bconf.Equals("log", "format", "console"),
+			description: "log.format == 'console'",
+			values:      []any{"console", "json"},
+			expected:    []bool{true, false},
+			unset:       false,
+		},
+		"not-equals": {
+			condition:   bconf.NotEquals("log", "format", "console"),
+			description: "log.format != 'console'",
+			values:      []any{"console", "json"},
+			expected:    []bool{false, true},
+			unset:       true,
+		},
+		"in": {
+			condition:   bconf.In("log", "level", "debug", "info"),
+			description: "log.level in ['debug', 'info']",
+			values:      []any{"debug", "info", "warn"},
+			expected:    []bool{true, true, false},
+			unset:       false,
+		},
+		"is-true": {
+			condition:   bconf.IsTrue("log", "color_enabled"),
+			description: "log.color_enabled is true",
+			values:      []any{true, false},
+			expected:    []bool{true, false},
+			unset:       false,
+		},
+		"is-set": {
+			condition:   bconf.IsSet("", "file"),
+			description: "file is set",
+			values:      []any{"", "log.txt"},
+			expected:    []bool{true, true},
+			unset:       false,
+		},
+		"matches": {
+			condition:   bconf.Matches("log", "format", "^con"),
+			description: "log.format matches '^con'",
+			values:      []any{"console", "json"},
+			expected:    []bool{true, false},
+			unset:       false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		describedCondition, ok := testCase.condition.(bconf.DescribedLoadCondition)
+		if !ok {
+			t.Fatalf("%s: expected condition to implement bconf.DescribedLoadCondition", name)
+		}
+
+		if description := describedCondition.Description(); description != testCase.description {
+			t.Errorf("%s: unexpected description '%s', expected '%s'", name, description, testCase.description)
+		}
+
+		multiFieldCondition, ok := testCase.condition.(bconf.MultiFieldLoadCondition)
+		if !ok {
+			t.Fatalf("%s: expected condition to implement bconf.MultiFieldLoadCondition", name)
+		}
+
+		dependencies := multiFieldCondition.FieldDependencies()
+		if len(dependencies) != 1 {
+			t.Fatalf("%s: unexpected field dependencies length '%d', expected '1'", name, len(dependencies))
+		}
+
+		for idx, value := range testCase.values {
+			load, err := multiFieldCondition.LoadValues(map[bconf.FieldDependency]any{dependencies[0]: value})
+			if err != nil {
+				t.Errorf("%s: unexpected error loading value '%v': %s", name, value, err)
+			}
+
+			if load != testCase.expected[idx] {
+				t.Errorf("%s: unexpected outcome '%v' for value '%v'", name, load, value)
+			}
+
+			if load, _ := testCase.condition.Clone().Load(value); load != testCase.expected[idx] {
+				t.Errorf("%s: unexpected clone outcome '%v' for value '%v'", name, load, value)
+			}
+		}
+
+		if load, _ := multiFieldCondition.LoadValues(map[bconf.FieldDependency]any{}); load != testCase.unset {
+			t.Errorf("%s: unexpected outcome '%v' for unset value", name, load)
+		}
+
+		if errs := testCase.condition.Validate(); len(errs) > 0 {
+			t.Errorf("%s: unexpected validation errors: %v", name, errs)
+		}
+	}
+}
+
+func TestValueConditionErrors(t *testing.T) {
+	if _, err := bconf.IsTrue("log", "color_enabled").Load("true"); err == nil {
+		t.Errorf("expected error loading is-true condition with non-bool value")
+	}
+
+	if _, err := bconf.Matches("log", "format", "^con").Load(1); err == nil {
+		t.Errorf("expected error loading matches condition with non-string value")
+	}
+
+	invalidPatternCondition := bconf.Matches("log", "format", "(")
+	if errs := invalidPatternCondition.Validate(); len(errs) != 1 {
+		t.Errorf("unexpected validation errors length '%d' for invalid pattern, expected '1'", len(errs))
+	}
+
+	if _, err := invalidPatternCondition.Load("console"); err == nil {
+		t.Errorf("expected error loading matches condition with invalid pattern")
+	}
+
+	if errs := bconf.Equals("log", "", "console").Validate(); len(errs) != 1 {
+		t.Errorf("unexpected validation errors length '%d' for missing field key, expected '1'", len(errs))
+	}
+}
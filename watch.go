@@ -0,0 +1,288 @@
+package bconf
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultWatchPollInterval = time.Second
+	defaultWatchDebounce     = 100 * time.Millisecond
+	// watchErrorBuffer is the number of errors the Watch error channel holds before further errors are dropped
+	watchErrorBuffer = 16
+)
+
+// WatchOptions defines how AppConfig.Watch detects and applies configuration changes.
+type WatchOptions struct {
+	// PollInterval defines how often PollingLoader sources are checked for changes (default: 1s)
+	PollInterval time.Duration
+	// Debounce defines how long to wait after the latest detected change before reloading (default: 100ms)
+	Debounce time.Duration
+}
+
+// SetWatchOptions sets the options used by Watch.
+func (c *AppConfig) SetWatchOptions(options WatchOptions) {
+	c.fieldSetLock.Lock()
+	defer c.fieldSetLock.Unlock()
+
+	c.watchOptions = options
+}
+
+// Watch detects configuration changes from loaders implementing PollingLoader or NotifyingLoader, and reloads every
+// field-set when a change is detected. Reloads are loaded and validated on a staged copy of the configuration, and
+// only applied if no errors are found, updating the fields returned by GetField in place. Reload errors, and errors
+// checking loader sources, are sent to the returned channel, which is closed when the context is done. The channel is
+// buffered, and errors are dropped while it is full, so reloads continue whether or not the channel is read. Watch
+// cannot be called before the app-config has been registered.
+func (c *AppConfig) Watch(ctx context.Context) (<-chan error, error) {
+	c.fieldSetLock.RLock()
+	registered, options, loaders := c.registered, c.watchOptions, c.loaders
+	c.fieldSetLock.RUnlock()
+
+	if !registered {
+		return nil, fmt.Errorf("Watch cannot be called before the app-config has been registered")
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaultWatchPollInterval
+	}
+
+	if options.Debounce <= 0 {
+		options.Debounce = defaultWatchDebounce
+	}
+
+	// loader subscriptions and forwarders are stopped when Watch returns an error, or when the watch loop ends
+	watchCtx, cancel := context.WithCancel(ctx)
+
+	notifications := make(chan struct{}, 1)
+	pollingLoaders := []PollingLoader{}
+
+	for _, loader := range loaders {
+		if pollingLoader, ok := loader.(PollingLoader); ok {
+			pollingLoaders = append(pollingLoaders, pollingLoader)
+		}
+
+		notifyingLoader, ok := loader.(NotifyingLoader)
+		if !ok {
+			continue
+		}
+
+		changes, err := notifyingLoader.Changes(watchCtx)
+		if err != nil {
+			cancel()
+
+			return nil, fmt.Errorf("problem watching loader '%s': %w", loader.Name(), err)
+		}
+
+		go forwardLoaderChanges(watchCtx, changes, notifications)
+	}
+
+	fingerprints := make(map[string]string, len(pollingLoaders))
+
+	for _, loader := range pollingLoaders {
+		fingerprint, err := loader.Fingerprint()
+		if err != nil {
+			cancel()
+
+			return nil, fmt.Errorf("problem watching loader '%s': %w", loader.Name(), err)
+		}
+
+		fingerprints[loader.Name()] = fingerprint
+	}
+
+	errs := make(chan error, watchErrorBuffer)
+
+	go func() {
+		defer cancel()
+
+		c.watch(watchCtx, options, pollingLoaders, fingerprints, notifications, errs)
+	}()
+
+	return errs, nil
+}
+
+func (c *AppConfig) watch(
+	ctx context.Context,
+	options WatchOptions,
+	pollingLoaders []PollingLoader,
+	fingerprints map[string]string,
+	notifications <-chan struct{},
+	errs chan<- error,
+) {
+	defer close(errs)
+
+	ticker := time.NewTicker(options.PollInterval)
+	defer ticker.Stop()
+
+	var debounceTimer *time.Timer
+
+	var debounce <-chan time.Time
+
+	scheduleReload := func() {
+		if debounceTimer != nil {
+			debounceTimer.Stop()
+		}
+
+		debounceTimer = time.NewTimer(options.Debounce)
+		debounce = debounceTimer.C
+	}
+
+	// errors are dropped while the channel is full, so that an unread channel doesn't block reloads
+	sendErrors := func(watchErrs ...error) {
+		for _, err := range watchErrs {
+			select {
+			case errs <- err:
+			default:
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if debounceTimer != nil {
+				debounceTimer.Stop()
+			}
+
+			return
+		case <-notifications:
+			scheduleReload()
+		case <-ticker.C:
+			changed, pollErrs := pollLoaderFingerprints(pollingLoaders, fingerprints)
+			if changed {
+				scheduleReload()
+			}
+
+			sendErrors(pollErrs...)
+		case <-debounce:
+			debounceTimer = nil
+			debounce = nil
+
+			sendErrors(c.reload()...)
+		}
+	}
+}
+
+// reload loads every field-set on a staged copy of the app-config, and applies the staged field values to the
+// app-config fields when no errors are found. When the app-config changes while the staged copy is loading, e.g. with
+// SetField or AddFieldSet, the reload is staged again from the current app-config while holding the lock, so that the
+// change is not lost.
+func (c *AppConfig) reload() []error {
+	c.fieldSetLock.RLock()
+	staged, generation := c.stagedClone(), c.generation
+	c.fieldSetLock.RUnlock()
+
+	// warnings from loading the staged copy are passed to the warning handler once the reload is applied or rejected
+	defer func() {
+		staged.notifyWarnings()
+	}()
+
+	if loadErrs := staged.loadFieldSets(); len(loadErrs) > 0 {
+		return reloadRejectedErrors(loadErrs)
+	}
+
+	return c.updateFields(func() []error {
+		if c.generation != generation {
+			staged = c.stagedClone()
+
+			if loadErrs := staged.loadFieldSets(); len(loadErrs) > 0 {
+				return reloadRejectedErrors(loadErrs)
+			}
+		}
+
+		c.applyStagedFields(staged)
+		c.profile = staged.profile
+
+		return nil
+	})
+}
+
+// applyStagedFields copies the loaded fields of a staged copy into the app-config fields, so that fields returned by
+// GetField before the reload keep receiving values.
+func (c *AppConfig) applyStagedFields(staged *AppConfig) {
+	for _, stagedFieldSet := range staged.orderedFieldSets {
+		fieldSet := c.fieldSets[stagedFieldSet.Key]
+
+		for fieldKey, stagedField := range stagedFieldSet.fieldMap {
+			*fieldSet.fieldMap[fieldKey] = *stagedField
+		}
+	}
+}
+
+func reloadRejectedErrors(loadErrs []error) []error {
+	errs := make([]error, len(loadErrs))
+	for index, err := range loadErrs {
+		errs[index] = fmt.Errorf("configuration reload rejected: %w", err)
+	}
+
+	return errs
+}
+
+// stagedClone returns a copy of the app-config with cloned field-sets, which can be loaded without modifying the
+// app-config.
+func (c *AppConfig) stagedClone() *AppConfig {
+	staged := &AppConfig{
+		appName:          c.appName,
+		appDescription:   c.appDescription,
+		fieldSets:        make(map[string]*FieldSet, len(c.fieldSets)),
+		orderedFieldSets: make(FieldSets, 0, len(c.orderedFieldSets)),
+		loaders:          c.loaders,
+		warningHandler:   c.warningHandler,
+		profileFieldSet:  c.profileFieldSet,
+		profileField:     c.profileField,
+		profile:          c.profile,
+		failFast:         c.failFast,
+		strict:           c.strict,
+		registered:       c.registered,
+	}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		clone := fieldSet.Clone()
+		staged.fieldSets[clone.Key] = clone
+		staged.orderedFieldSets = append(staged.orderedFieldSets, clone)
+	}
+
+	return staged
+}
+
+// pollLoaderFingerprints updates the fingerprints of the polling loaders, returning whether any fingerprint changed.
+func pollLoaderFingerprints(loaders []PollingLoader, fingerprints map[string]string) (bool, []error) {
+	changed := false
+	errs := []error{}
+
+	for _, loader := range loaders {
+		fingerprint, err := loader.Fingerprint()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("problem watching loader '%s': %w", loader.Name(), err))
+			continue
+		}
+
+		if fingerprints[loader.Name()] != fingerprint {
+			fingerprints[loader.Name()] = fingerprint
+			changed = true
+		}
+	}
+
+	return changed, errs
+}
+
+// forwardLoaderChanges forwards notifications from a loader to the watch loop, dropping notifications while one is
+// already pending.
+func forwardLoaderChanges(ctx context.Context, changes <-chan struct{}, notifications chan<- struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-changes:
+			if !ok {
+				return
+			}
+
+			select {
+			case notifications <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
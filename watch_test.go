@@ -0,0 +1,425 @@
+package bconf_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rheisen/bconf"
+)
+
+func TestAppConfigWatchPollingLoader(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	writeWatchTestFile(t, configPath, `{"log": {"level": "info"}}`)
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.SetLoaders(bconf.NewJSONFileLoaderWithAttributes(json.Unmarshal, configPath))
+	appConfig.SetWatchOptions(bconf.WatchOptions{PollInterval: 5 * time.Millisecond, Debounce: 5 * time.Millisecond})
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("log").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Enumeration("debug", "info").Required().Create(),
+	).Create())
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watchErrs, err := appConfig.Watch(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error watching app-config: %s", err)
+	}
+
+	writeWatchTestFile(t, configPath, `{"log": {"level": "debug"}}`)
+
+	waitForWatchTestValue(t, appConfig, "debug")
+
+	writeWatchTestFile(t, configPath, `{"log": {"level": "invalid"}}`)
+
+	select {
+	case err := <-watchErrs:
+		if !strings.Contains(err.Error(), "configuration reload rejected") {
+			t.Errorf("unexpected watch error: %s", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected watch error for invalid configuration change")
+	}
+
+	if val, _ := appConfig.GetString("log", "level"); val != "debug" {
+		t.Errorf("unexpected value applied from invalid configuration change: '%s'", val)
+	}
+
+	cancel()
+
+	// errors buffered before the cancel can still be read, before the channel is closed
+	for {
+		select {
+		case err, ok := <-watchErrs:
+			if !ok {
+				return
+			}
+
+			if !strings.Contains(err.Error(), "configuration reload rejected") {
+				t.Errorf("unexpected watch error: %s", err)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("expected watch error channel to be closed after cancel")
+		}
+	}
+}
+
+func TestAppConfigWatchNotifyingLoader(t *testing.T) {
+	loader := &notifyingTestLoader{values: map[string]string{"log_level": "info"}, changes: make(chan struct{})}
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.SetLoaders(loader)
+	appConfig.SetWatchOptions(bconf.WatchOptions{Debounce: time.Millisecond})
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("log").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Create(),
+	).Create())
+
+	if _, err := appConfig.Watch(context.Background()); err == nil {
+		t.Fatalf("expected error watching app-config before register")
+	}
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan bconf.FieldChange, 1)
+	_ = appConfig.OnFieldSetChange("log", func(fieldChanges []bconf.FieldChange) {
+		changes <- fieldChanges[0]
+	})
+
+	if _, err := appConfig.Watch(ctx); err != nil {
+		t.Fatalf("unexpected error watching app-config: %s", err)
+	}
+
+	field, _ := appConfig.GetField("log", "level")
+
+	loader.set("log_level", "debug")
+	loader.changes <- struct{}{}
+
+	select {
+	case change := <-changes:
+		if change.OldValue != "info" || change.NewValue != "debug" {
+			t.Errorf("unexpected field change from watch reload: %s", change)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected field change from watch reload")
+	}
+
+	waitForWatchTestValue(t, appConfig, "debug")
+
+	if reloadedField, _ := appConfig.GetField("log", "level"); reloadedField != field {
+		t.Errorf("expected field returned before the reload to be updated in place")
+	}
+}
+
+func TestAppConfigWatchUnreadErrors(t *testing.T) {
+	loader := &notifyingTestLoader{values: map[string]string{"log_level": "info"}, changes: make(chan struct{})}
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.SetLoaders(loader)
+	appConfig.SetWatchOptions(bconf.WatchOptions{Debounce: time.Millisecond})
+
+	fields := []*bconf.Field{bconf.FB().Key("level").Type(bconf.String).Create()}
+	for index := 0; index < 20; index++ {
+		fields = append(fields, bconf.FB().Key(fmt.Sprintf("count_%d", index)).Type(bconf.Int).Create())
+	}
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("log").Fields(fields...).Create())
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the error channel is never read
+	if _, err := appConfig.Watch(ctx); err != nil {
+		t.Fatalf("unexpected error watching app-config: %s", err)
+	}
+
+	for index := 0; index < 20; index++ {
+		loader.set(fmt.Sprintf("log_count_%d", index), "invalid")
+	}
+
+	loader.set("log_level", "rejected")
+	loader.changes <- struct{}{}
+
+	time.Sleep(50 * time.Millisecond)
+
+	if val, _ := appConfig.GetString("log", "level"); val != "info" {
+		t.Fatalf("unexpected value applied from invalid configuration change: '%s'", val)
+	}
+
+	for index := 0; index < 20; index++ {
+		loader.set(fmt.Sprintf("log_count_%d", index), "1")
+	}
+
+	loader.set("log_level", "debug")
+	loader.changes <- struct{}{}
+
+	waitForWatchTestValue(t, appConfig, "debug")
+}
+
+func TestAppConfigWatchReloadKeepsConcurrentChanges(t *testing.T) {
+	loader := &blockingTestLoader{
+		notifyingTestLoader: notifyingTestLoader{values: map[string]string{}, changes: make(chan struct{})},
+		entered:             make(chan struct{}),
+		release:             make(chan struct{}),
+	}
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.SetLoaders(loader)
+	appConfig.SetWatchOptions(bconf.WatchOptions{Debounce: time.Millisecond})
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("log").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Default("info").Create(),
+		bconf.FB().Key("format").Type(bconf.String).Default("json").Create(),
+	).Create())
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := appConfig.Watch(ctx); err != nil {
+		t.Fatalf("unexpected error watching app-config: %s", err)
+	}
+
+	loader.set("log_format", "console")
+	loader.block()
+	loader.changes <- struct{}{}
+
+	select {
+	case <-loader.entered:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected watch reload to read the loader")
+	}
+
+	// the reload is staged, and blocked reading the loader
+	if err := appConfig.SetField("log", "level", "debug"); err != nil {
+		t.Fatalf("unexpected error setting field: %s", err)
+	}
+
+	errs := appConfig.AddFieldSet(bconf.FSB().Key("added").Fields(
+		bconf.FB().Key("value").Type(bconf.String).Default("added").Create(),
+	).Create())
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors adding field-set: %v", errs)
+	}
+
+	close(loader.release)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if val, _ := appConfig.GetString("log", "format"); val == "console" {
+			break
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if val, _ := appConfig.GetString("log", "format"); val != "console" {
+		t.Fatalf("unexpected value '%s' after configuration change, expected 'console'", val)
+	}
+
+	if val, _ := appConfig.GetString("log", "level"); val != "debug" {
+		t.Errorf("unexpected value '%s' set during reload, expected 'debug'", val)
+	}
+
+	if val, err := appConfig.GetString("added", "value"); err != nil || val != "added" {
+		t.Errorf("unexpected value '%s' from field-set added during reload: %v", val, err)
+	}
+}
+
+func TestAppConfigWatchErrorStopsLoaderSubscriptions(t *testing.T) {
+	loader := &subscribingTestLoader{
+		notifyingTestLoader: notifyingTestLoader{values: map[string]string{}, changes: make(chan struct{})},
+	}
+
+	appConfig := bconf.NewAppConfig("app", "description")
+	_ = appConfig.SetLoaders(loader, &failingPollingTestLoader{})
+
+	_ = appConfig.AddFieldSet(bconf.FSB().Key("log").Fields(
+		bconf.FB().Key("level").Type(bconf.String).Default("info").Create(),
+	).Create())
+
+	if errs := appConfig.Register(false); len(errs) > 0 {
+		t.Fatalf("unexpected errors registering app-config: %v", errs)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := appConfig.Watch(ctx); err == nil || !strings.Contains(err.Error(), "fingerprint failure") {
+		t.Fatalf("expected error watching loader with failing fingerprint, got: %v", err)
+	}
+
+	select {
+	case <-loader.ctx.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected loader subscription to be stopped when Watch returns an error")
+	}
+}
+
+// subscribingTestLoader records the context passed to Changes.
+type subscribingTestLoader struct {
+	notifyingTestLoader
+	ctx context.Context
+}
+
+func (l *subscribingTestLoader) CloneLoader() bconf.Loader {
+	return l
+}
+
+func (l *subscribingTestLoader) Changes(ctx context.Context) (<-chan struct{}, error) {
+	l.ctx = ctx
+
+	return l.changes, nil
+}
+
+// failingPollingTestLoader returns an error from every Fingerprint call.
+type failingPollingTestLoader struct{}
+
+func (l *failingPollingTestLoader) CloneLoader() bconf.Loader {
+	return l
+}
+
+func (l *failingPollingTestLoader) Name() string {
+	return "failing_polling_test"
+}
+
+func (l *failingPollingTestLoader) Get(fieldSetKey, fieldKey string) (string, bool) {
+	return "", false
+}
+
+func (l *failingPollingTestLoader) GetMap(fieldSetKey string, fieldKeys []string) map[string]string {
+	return map[string]string{}
+}
+
+func (l *failingPollingTestLoader) HelpString(fieldSetKey, fieldKey string) string {
+	return ""
+}
+
+func (l *failingPollingTestLoader) Fingerprint() (string, error) {
+	return "", fmt.Errorf("fingerprint failure")
+}
+
+// blockingTestLoader blocks the first GetMap call after block is called, until release is closed.
+type blockingTestLoader struct {
+	notifyingTestLoader
+	entered chan struct{}
+	release chan struct{}
+	blocked atomic.Bool
+}
+
+func (l *blockingTestLoader) CloneLoader() bconf.Loader {
+	return l
+}
+
+func (l *blockingTestLoader) GetMap(fieldSetKey string, fieldKeys []string) map[string]string {
+	if l.blocked.CompareAndSwap(true, false) {
+		close(l.entered)
+		<-l.release
+	}
+
+	return l.notifyingTestLoader.GetMap(fieldSetKey, fieldKeys)
+}
+
+func (l *blockingTestLoader) block() {
+	l.blocked.Store(true)
+}
+
+func waitForWatchTestValue(t *testing.T, appConfig *bconf.AppConfig, expected string) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+
+	for time.Now().Before(deadline) {
+		if val, _ := appConfig.GetString("log", "level"); val == expected {
+			return
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	val, _ := appConfig.GetString("log", "level")
+	t.Fatalf("unexpected value '%s' after configuration change, expected '%s'", val, expected)
+}
+
+func writeWatchTestFile(t *testing.T, path, contents string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("unexpected error writing file: %s", err)
+	}
+}
+
+type notifyingTestLoader struct {
+	values  map[string]string
+	changes chan struct{}
+	lock    sync.Mutex
+}
+
+func (l *notifyingTestLoader) CloneLoader() bconf.Loader {
+	return l
+}
+
+func (l *notifyingTestLoader) Name() string {
+	return "notifying_test"
+}
+
+func (l *notifyingTestLoader) Get(fieldSetKey, fieldKey string) (string, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	value, found := l.values[fieldSetKey+"_"+fieldKey]
+
+	return value, found
+}
+
+func (l *notifyingTestLoader) GetMap(fieldSetKey string, fieldKeys []string) map[string]string {
+	values := map[string]string{}
+
+	for _, fieldKey := range fieldKeys {
+		if value, found := l.Get(fieldSetKey, fieldKey); found {
+			values[fieldKey] = value
+		}
+	}
+
+	return values
+}
+
+func (l *notifyingTestLoader) HelpString(fieldSetKey, fieldKey string) string {
+	return ""
+}
+
+func (l *notifyingTestLoader) Changes(ctx context.Context) (<-chan struct{}, error) {
+	return l.changes, nil
+}
+
+func (l *notifyingTestLoader) set(key, value string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.values[key] = value
+}